@@ -0,0 +1,62 @@
+package array
+
+// SetAt set element data at index, like js arr[index] = data
+// 	index out of length extend array with nil elements or undefined ones of Semantics
+// 	return false if index < 0 or change is forbidden
+func (a *Array) SetAt(index int, data interface{}) bool {
+	if index < 0 {
+		return false
+	}
+
+	l := len(a.Items)
+	if index < l {
+		if a.deny(opAssign) {
+			return false
+		}
+		old := a.Items[index].Data
+		a.Items[index].Data = a.wrap(data)
+		if a.observed() && !a.sameValueZero(old, a.Items[index].Data) {
+			a.notify(ChangeRecord{Type: ChangeUpdate, Index: index, OldValue: old})
+		}
+		return true
+	}
+
+	if a.deny(opAdd) {
+		return false
+	}
+	for i := l; i < index; i++ {
+		a.Items = append(a.Items, a.hole())
+	}
+	a.Items = append(a.Items, ArrayItem{Data: a.wrap(data)})
+	a.notify(ChangeRecord{Type: ChangeSplice, Index: l, AddedCount: index - l + 1})
+	return true
+}
+
+// SetLength change array length, like js arr.length = n
+// 	new elements are nil or undefined ones of Semantics
+// 	return false if n < 0 or change is forbidden
+func (a *Array) SetLength(n int) bool {
+	l := len(a.Items)
+	switch {
+	case n < 0:
+		return false
+	case n > l:
+		if a.deny(opAdd) {
+			return false
+		}
+		for i := l; i < n; i++ {
+			a.Items = append(a.Items, a.hole())
+		}
+		a.notify(ChangeRecord{Type: ChangeSplice, Index: l, AddedCount: n - l})
+	case n < l:
+		if a.deny(opDelete) {
+			return false
+		}
+		removed := a.Items[n:]
+		a.Items = a.Items[:n]
+		if a.observed() {
+			a.notify(ChangeRecord{Type: ChangeSplice, Index: n, Removed: copyItems(removed)})
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package array
+
+import "testing"
+
+func TestArraySetAt(t *testing.T) {
+	tests := []struct {
+		arr         *Array
+		index       int
+		data        interface{}
+		want        *Array
+		ok          bool
+		description string
+	}{
+		{
+			arr:         MakeArray(1, 2, 3),
+			index:       1,
+			data:        "a",
+			want:        MakeArray(1, "a", 3),
+			ok:          true,
+			description: "set in range",
+		},
+		{
+			arr:         MakeArray(1),
+			index:       3,
+			data:        "a",
+			want:        MakeArray(1, nil, nil, "a"),
+			ok:          true,
+			description: "set out of range",
+		},
+		{
+			arr:         MakeArray(1),
+			index:       -1,
+			data:        "a",
+			want:        MakeArray(1),
+			description: "negative index",
+		},
+		{
+			arr:         MakeArray(1).Seal(),
+			index:       0,
+			data:        "a",
+			want:        MakeArray("a").Seal(),
+			ok:          true,
+			description: "set sealed",
+		},
+		{
+			arr:         MakeArray(1).Freeze(),
+			index:       0,
+			data:        "a",
+			want:        MakeArray(1),
+			description: "set frozen",
+		},
+	}
+
+	for _, tt := range tests {
+		ok := tt.arr.SetAt(tt.index, tt.data)
+		TestLog("SetAt", t, tt.index, tt.arr.Items, tt.want.Items, tt.description)
+		TestLog("SetAt", t, tt.index, ok, tt.ok, tt.description+" result")
+	}
+}
+
+func TestArraySetLength(t *testing.T) {
+	tests := []struct {
+		arr         *Array
+		length      int
+		want        *Array
+		ok          bool
+		description string
+	}{
+		{
+			arr:         MakeArray(1, 2, 3),
+			length:      1,
+			want:        MakeArray(1),
+			ok:          true,
+			description: "truncate",
+		},
+		{
+			arr:         MakeArray(1),
+			length:      3,
+			want:        MakeArray(1, nil, nil),
+			ok:          true,
+			description: "extend",
+		},
+		{
+			arr:         MakeArray(1, 2).Seal(),
+			length:      1,
+			want:        MakeArray(1, 2),
+			description: "truncate sealed",
+		},
+		{
+			arr:         MakeArray(1).PreventExtensions(),
+			length:      2,
+			want:        MakeArray(1),
+			description: "extend not extensible",
+		},
+	}
+
+	for _, tt := range tests {
+		ok := tt.arr.SetLength(tt.length)
+		TestLog("SetLength", t, tt.length, tt.arr.Items, tt.want.Items, tt.description)
+		TestLog("SetLength", t, tt.length, ok, tt.ok, tt.description+" result")
+	}
+}
@@ -11,6 +11,8 @@ type ArrayItem struct {
 
 type Array struct {
 	Items []ArrayItem
+
+	meta *meta
 }
 
 const LastElement = -9223372036854775808
@@ -40,21 +42,36 @@ func MakeNArray(n int) *Array {
 
 // Push just push to end items
 func (a *Array) Push(items ...interface{}) *Array {
+	if a.deny(opAdd) || len(items) == 0 {
+		return a
+	}
+	start := len(a.Items)
 	for _, v := range items {
-		a.Items = append(a.Items, ArrayItem{Data: v})
+		a.Items = append(a.Items, ArrayItem{Data: a.wrap(v)})
 	}
+	a.notify(ChangeRecord{Type: ChangeSplice, Index: start, AddedCount: len(items)})
 	return a
 }
 
 // Unshift just push to start items
 func (a *Array) Unshift(items ...interface{}) *Array {
+	if a.deny(opAdd) || len(items) == 0 {
+		return a
+	}
 	for _, v := range items {
-		a.Items = append([]ArrayItem{{Data: v}}, a.Items...)
+		a.Items = append([]ArrayItem{{Data: a.wrap(v)}}, a.Items...)
 	}
+	a.notify(ChangeRecord{Type: ChangeSplice, Index: 0, AddedCount: len(items)})
 	return a
 }
 
 func slice(a *Array, start, end int) []ArrayItem {
+	s, e := bounds(a, start, end)
+	return a.Items[s:e]
+}
+
+// bounds return real slice indexes of start & end
+func bounds(a *Array, start, end int) (int, int) {
 	e := end
 	s := start
 	l := len(a.Items)
@@ -75,7 +92,7 @@ func slice(a *Array, start, end int) []ArrayItem {
 		e = s
 	}
 
-	return a.Items[s:e]
+	return s, e
 }
 
 // NewSlice return new slice between start & end
@@ -83,28 +100,54 @@ func slice(a *Array, start, end int) []ArrayItem {
 // 	if start/end = LastElement, then equal to array length
 func (a *Array) NewSlice(start, end int) *Array {
 	items := slice(a, start, end)
-	return &Array{Items: items}
+	return a.derive(items)
 }
 
 // Slice make current array to slice between start & end
 // 	if start/end < 0, then count from end
 // 	if start/end = LastElement, then equal to array length
 func (a *Array) Slice(start, end int) *Array {
-	a.Items = slice(a, start, end)
+	if a.deny(opDelete) {
+		return a
+	}
+	old := a.Items
+	s, e := bounds(a, start, end)
+	a.Items = old[s:e]
+	if a.observed() {
+		// items are removed from tail, then from head
+		if e < len(old) {
+			a.notify(ChangeRecord{Type: ChangeSplice, Index: e, Removed: copyItems(old[e:])})
+		}
+		if s > 0 {
+			a.notify(ChangeRecord{Type: ChangeSplice, Index: 0, Removed: copyItems(old[:s])})
+		}
+	}
 	return a
 }
 
 // Pop return&remove last element
 func (a *Array) Pop() ArrayItem {
+	if a.deny(opDelete) {
+		return ArrayItem{}
+	}
 	i := a.Items[len(a.Items)-1]
-	a.Slice(0, -1)
+	a.Items = slice(a, 0, -1)
+	if a.observed() {
+		a.notify(ChangeRecord{Type: ChangeSplice, Index: len(a.Items), Removed: []ArrayItem{i}})
+	}
 	return i
 }
 
 // Shift return&remove first element
 func (a *Array) Shift() ArrayItem {
+	if a.deny(opDelete) {
+		return ArrayItem{}
+	}
 	i := a.Items[0]
-	a.Slice(1, len(a.Items))
+	a.Items = slice(a, 1, len(a.Items))
+	if a.observed() {
+		a.notify(ChangeRecord{Type: ChangeSplice, Index: 0, Removed: []ArrayItem{i}})
+	}
 	return i
 }
 
@@ -157,8 +200,9 @@ func (a *Array) Includes(data interface{}, fromIndex int) bool {
 	if fromIndex < 0 {
 		return false
 	}
+	data = a.wrap(data)
 	for i := fromIndex; i < len(a.Items); i++ {
-		if a.Items[i].Data == data {
+		if a.sameValueZero(a.Items[i].Data, data) {
 			return true
 		}
 	}
@@ -167,23 +211,27 @@ func (a *Array) Includes(data interface{}, fromIndex int) bool {
 
 // Fill fill all element equal to data
 func (a *Array) Fill(data interface{}) *Array {
+	if a.deny(opAssign) {
+		return a
+	}
+	data = a.wrap(data)
 	for i := range a.Items {
+		old := a.Items[i].Data
 		a.Items[i].Data = data
+		if a.observed() && !a.sameValueZero(old, data) {
+			a.notify(ChangeRecord{Type: ChangeUpdate, Index: i, OldValue: old})
+		}
 	}
 	return a
 }
 
 // Join return joined by separator string
+// 	elements are converted like in js: nil is empty string, nested arrays are joined by ","
 func (a *Array) Join(separator string) string {
-	r := ""
 	if separator == "" {
 		separator = ","
 	}
-	for _, v := range a.Items {
-		r += fmt.Sprint(v.Data) + separator
-	}
-	r = r[0 : len(r)-1]
-	return r
+	return a.join(separator, map[*Array]bool{})
 }
 
 // IndexOf return finding element index or -1
@@ -191,8 +239,9 @@ func (a *Array) IndexOf(data interface{}, fromIndex int) int {
 	if fromIndex < 0 {
 		return -1
 	}
+	data = a.wrap(data)
 	for i := fromIndex; i < len(a.Items); i++ {
-		if a.Items[i].Data == data {
+		if a.strictEqual(a.Items[i].Data, data) {
 			return i - fromIndex
 		}
 	}
@@ -204,8 +253,9 @@ func (a *Array) LastIndexOf(data interface{}, fromIndex int) int {
 	if fromIndex < 0 {
 		return -1
 	}
+	data = a.wrap(data)
 	for i := len(a.Items) - 1 - fromIndex; i >= 0; i-- {
-		if a.Items[i].Data == data {
+		if a.strictEqual(a.Items[i].Data, data) {
 			return i
 		}
 	}
@@ -214,15 +264,20 @@ func (a *Array) LastIndexOf(data interface{}, fromIndex int) int {
 
 // Reverse return reversed array
 func (a *Array) Reverse() *Array {
+	if a.deny(opAssign) {
+		return a
+	}
+	old := a.reorderRecord()
 	for i, j := 0, len(a.Items)-1; i < j; i, j = i+1, j-1 {
 		a.Items[i], a.Items[j] = a.Items[j], a.Items[i]
 	}
+	a.notify(old...)
 	return a
 }
 
 // Filter return new filtered array; remove elements not equal in callback
 func (a *Array) Filter(callback func(value ArrayItem, index int, array *Array) bool) *Array {
-	arr := NewArray()
+	arr := a.derive(nil)
 	for i, v := range a.Items {
 		if callback(v, i, a) {
 			arr.Push(v.Data)
@@ -233,7 +288,7 @@ func (a *Array) Filter(callback func(value ArrayItem, index int, array *Array) b
 
 // Map return new array; elements maked in callback
 func (a *Array) Map(callback func(value ArrayItem, index int, array *Array) ArrayItem) *Array {
-	arr := NewArray()
+	arr := a.derive(nil)
 	for i, v := range a.Items {
 		arr.Push(callback(v, i, a).Data)
 	}
@@ -291,7 +346,17 @@ func qsort(arr []ArrayItem, compareFunction func(a, b ArrayItem) int) []ArrayIte
 // 	if compareFunction(a, b) < 0, sort will place a before b.
 // 	if compareFunction(a, b) == 0, sort will not change order between a and b, but change order among other element.
 // 	if compareFunction(a, b) > 0, sort will place b before a.
+// 	if compareFunction is nil, sort like js default sort: stable, by strings in UTF-16 order, nil at the end
 func (a *Array) Sort(compareFunction func(a, b ArrayItem) int) *Array {
-	a.Items = qsort(a.Items, compareFunction)
+	if a.deny(opAssign) {
+		return a
+	}
+	old := a.reorderRecord()
+	if compareFunction == nil {
+		a.defaultSort()
+	} else {
+		a.Items = qsort(a.Items, compareFunction)
+	}
+	a.notify(old...)
 	return a
 }
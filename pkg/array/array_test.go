@@ -630,19 +630,19 @@ func TestArrayFill(t *testing.T) {
 		{
 			filler:      2,
 			length:      5,
-			want:        &Array{[]ArrayItem{{2}, {2}, {2}, {2}, {2}}},
+			want:        &Array{Items: []ArrayItem{{2}, {2}, {2}, {2}, {2}}},
 			description: "fill int",
 		},
 		{
 			filler:      "str",
 			length:      3,
-			want:        &Array{[]ArrayItem{{"str"}, {"str"}, {"str"}}},
+			want:        &Array{Items: []ArrayItem{{"str"}, {"str"}, {"str"}}},
 			description: "fill string",
 		},
 		{
 			filler:      ArrayItem{2},
 			length:      3,
-			want:        &Array{[]ArrayItem{{ArrayItem{2}}, {ArrayItem{2}}, {ArrayItem{2}}}},
+			want:        &Array{Items: []ArrayItem{{ArrayItem{2}}, {ArrayItem{2}}, {ArrayItem{2}}}},
 			description: "fill struct",
 		},
 	}
@@ -688,6 +688,22 @@ func TestArrayJoin(t *testing.T) {
 			want:        `1"2"3`,
 			description: `join '"' seperator`,
 		},
+		{
+			incoming:    []interface{}{1, nil, 3},
+			separator:   ", ",
+			want:        "1, , 3",
+			description: "nil element and long separator",
+		},
+		{
+			incoming:    []interface{}{1, MakeArray(2, MakeArray(3, nil)), 0.000001},
+			want:        "1,2,3,,0.000001",
+			description: "nested arrays and js number",
+		},
+		{
+			incoming:    []interface{}{},
+			want:        "",
+			description: "empty array",
+		},
 	}
 
 	for _, tt := range tests {
@@ -831,17 +847,17 @@ func TestArrayReverse(t *testing.T) {
 	}{
 		{
 			incoming:    []interface{}{1, 2, 3},
-			want:        &Array{[]ArrayItem{{3}, {2}, {1}}},
+			want:        &Array{Items: []ArrayItem{{3}, {2}, {1}}},
 			description: "reverse int",
 		},
 		{
 			incoming:    []interface{}{"str1", "str2", "str3"},
-			want:        &Array{[]ArrayItem{{"str3"}, {"str2"}, {"str1"}}},
+			want:        &Array{Items: []ArrayItem{{"str3"}, {"str2"}, {"str1"}}},
 			description: "reverse string",
 		},
 		{
 			incoming:    []interface{}{"str1", 1, true},
-			want:        &Array{[]ArrayItem{{true}, {1}, {"str1"}}},
+			want:        &Array{Items: []ArrayItem{{true}, {1}, {"str1"}}},
 			description: "reverse mix",
 		},
 	}
@@ -861,17 +877,17 @@ func TestArrayFilter(t *testing.T) {
 	}{
 		{
 			incoming:    []interface{}{1, 2, 3},
-			want:        &Array{[]ArrayItem{{3}}},
+			want:        &Array{Items: []ArrayItem{{3}}},
 			description: "filter int",
 		},
 		{
 			incoming:    []interface{}{"str1", "str2", "str3"},
-			want:        &Array{[]ArrayItem{{"str2"}, {"str3"}}},
+			want:        &Array{Items: []ArrayItem{{"str2"}, {"str3"}}},
 			description: "filter string",
 		},
 		{
 			incoming:    []interface{}{"str1", 1, true},
-			want:        &Array{[]ArrayItem{{true}}},
+			want:        &Array{Items: []ArrayItem{{true}}},
 			description: "filter mix",
 		},
 	}
@@ -909,17 +925,17 @@ func TestArrayMap(t *testing.T) {
 	}{
 		{
 			incoming:    []interface{}{1, 2, 3},
-			want:        &Array{[]ArrayItem{{3}, {4}, {5}}},
+			want:        &Array{Items: []ArrayItem{{3}, {4}, {5}}},
 			description: "map int",
 		},
 		{
 			incoming:    []interface{}{"str1", "str2", "str3"},
-			want:        &Array{[]ArrayItem{{"sstr1"}, {"sstr2"}, {"sstr3"}}},
+			want:        &Array{Items: []ArrayItem{{"sstr1"}, {"sstr2"}, {"sstr3"}}},
 			description: "map string",
 		},
 		{
 			incoming:    []interface{}{"str1", 1, true},
-			want:        &Array{[]ArrayItem{{}, {}, {true}}},
+			want:        &Array{Items: []ArrayItem{{}, {}, {true}}},
 			description: "map mix",
 		},
 	}
@@ -1068,12 +1084,12 @@ func TestArraySort(t *testing.T) {
 	}{
 		{
 			incoming:    []interface{}{1, 5, 4, 8},
-			want:        &Array{[]ArrayItem{{1}, {4}, {5}, {8}}},
+			want:        &Array{Items: []ArrayItem{{1}, {4}, {5}, {8}}},
 			description: "sort int",
 		},
 		{
 			incoming:    []interface{}{"str3", "str1", "str2"},
-			want:        &Array{[]ArrayItem{{"str1"}, {"str2"}, {"str3"}}},
+			want:        &Array{Items: []ArrayItem{{"str1"}, {"str2"}, {"str3"}}},
 			description: "sort string",
 		},
 	}
@@ -1104,3 +1120,37 @@ func TestArraySort(t *testing.T) {
 	})
 	TestLog("Sort", t, tt.incoming, got, tt.want, tt.description)
 }
+
+func TestArraySortDefault(t *testing.T) {
+	tests := []struct {
+		incoming    []interface{}
+		want        []interface{}
+		description string
+	}{
+		{
+			incoming:    []interface{}{10, 9, 1, 100},
+			want:        []interface{}{1, 10, 100, 9},
+			description: "numbers sorted as strings",
+		},
+		{
+			incoming:    []interface{}{"b", nil, "a", nil, "c"},
+			want:        []interface{}{"a", "b", "c", nil, nil},
+			description: "nil at the end",
+		},
+		{
+			incoming:    []interface{}{"\uff61", "\U0001F600"},
+			want:        []interface{}{"\U0001F600", "\uff61"},
+			description: "utf-16 code units order",
+		},
+		{
+			incoming:    []interface{}{1, "1", 1.0},
+			want:        []interface{}{1, "1", 1.0},
+			description: "stable sort of equal strings",
+		},
+	}
+
+	for _, tt := range tests {
+		got := MakeArray(tt.incoming...).Sort(nil)
+		TestLog("Sort", t, tt.incoming, got, MakeArray(tt.want...), tt.description)
+	}
+}
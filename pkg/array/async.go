@@ -0,0 +1,134 @@
+package array
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Future is value computed asynchronously, like js promise
+type Future interface {
+	Await(ctx context.Context) (interface{}, error)
+}
+
+// futureType is type of Future interface, used to accept slices of any Future implementation
+var futureType = reflect.TypeOf((*Future)(nil)).Elem()
+
+// PullFunc return next value of async source; ok is false when source is exhausted
+type PullFunc func(ctx context.Context) (value interface{}, ok bool, err error)
+
+// FromAsync return new Array collected from async source, like js Array.fromAsync
+// 	source can be:
+// 	receive channel - values are received until channel is closed
+// 	PullFunc - called until it return not ok or error
+// 	slice of Future, like []Future or []*promise.Promise - futures are awaited in order
+// 	*Array or Iterator - values are taken in order, Future values are awaited
+// 	on ctx cancel or source error collected values are returned with error
+func FromAsync(ctx context.Context, source interface{}) (*Array, error) {
+	r := NewArray()
+	switch s := source.(type) {
+	case PullFunc:
+		return r, fromPull(ctx, r, s)
+	case func(ctx context.Context) (interface{}, bool, error):
+		return r, fromPull(ctx, r, s)
+	case *Array:
+		for _, v := range s.Items {
+			if err := r.pushAwaited(ctx, v.Data); err != nil {
+				return r, err
+			}
+		}
+		return r, nil
+	case Iterator:
+		for {
+			v, done := s.Next()
+			if done {
+				return r, nil
+			}
+			if err := r.pushAwaited(ctx, v); err != nil {
+				return r, err
+			}
+		}
+	}
+
+	src := reflect.ValueOf(source)
+	if src.Kind() == reflect.Slice && src.Type().Elem().Implements(futureType) {
+		for i := 0; i < src.Len(); i++ {
+			if err := r.pushAwaited(ctx, src.Index(i).Interface()); err != nil {
+				return r, err
+			}
+		}
+		return r, nil
+	}
+	if src.Kind() != reflect.Chan || src.Type().ChanDir()&reflect.RecvDir == 0 {
+		return r, &TypeError{Message: fmt.Sprintf("%T is not async iterable", source)}
+	}
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: src},
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return r, err
+		}
+		chosen, v, ok := reflect.Select(cases)
+		if chosen == 0 {
+			return r, ctx.Err()
+		}
+		if !ok {
+			return r, nil
+		}
+		r.Push(v.Interface())
+	}
+}
+
+func fromPull(ctx context.Context, r *Array, pull PullFunc) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		v, ok, err := pull(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		r.Push(v)
+	}
+}
+
+func (a *Array) pushAwaited(ctx context.Context, v interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if f, ok := v.(Future); ok {
+		res, err := f.Await(ctx)
+		if err != nil {
+			return err
+		}
+		v = res
+	}
+	a.Push(v)
+	return nil
+}
+
+// ToChannel return channel with all array elements data
+// 	channel is closed after last element or on ctx cancel
+// 	elements are taken at call time, later changes of array are not sent
+func (a *Array) ToChannel(ctx context.Context, buffer int) <-chan interface{} {
+	items := make([]ArrayItem, len(a.Items))
+	copy(items, a.Items)
+
+	ch := make(chan interface{}, buffer)
+	go func() {
+		defer close(ch)
+		for _, v := range items {
+			select {
+			case ch <- v.Data:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
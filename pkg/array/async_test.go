@@ -0,0 +1,144 @@
+package array
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testFuture struct {
+	value interface{}
+	err   error
+}
+
+func (f testFuture) Await(ctx context.Context) (interface{}, error) {
+	return f.value, f.err
+}
+
+func TestArrayFromAsync(t *testing.T) {
+	errTest := errors.New("test")
+
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	n := 0
+	pull := func(ctx context.Context) (interface{}, bool, error) {
+		n++
+		if n > 2 {
+			return nil, false, nil
+		}
+		return n, true, nil
+	}
+
+	tests := []struct {
+		source      interface{}
+		want        *Array
+		err         error
+		description string
+	}{
+		{
+			source:      ch,
+			want:        MakeArray(1, 2, 3),
+			description: "closed channel",
+		},
+		{
+			source:      pull,
+			want:        MakeArray(1, 2),
+			description: "pull func",
+		},
+		{
+			source:      []Future{testFuture{value: "a"}, testFuture{value: "b"}},
+			want:        MakeArray("a", "b"),
+			description: "futures",
+		},
+		{
+			source:      []Future{testFuture{value: "a"}, testFuture{err: errTest}, testFuture{value: "c"}},
+			want:        MakeArray("a"),
+			err:         errTest,
+			description: "rejected future return partial result",
+		},
+		{
+			source:      []testFuture{{value: "a"}, {value: "b"}},
+			want:        MakeArray("a", "b"),
+			description: "slice of concrete futures",
+		},
+		{
+			source:      []*testFuture{{value: 1}, {value: 2}},
+			want:        MakeArray(1, 2),
+			description: "slice of future pointers",
+		},
+		{
+			source:      MakeArray(1, testFuture{value: 2}),
+			want:        MakeArray(1, 2),
+			description: "array with futures",
+		},
+		{
+			source:      &countIterator{n: 2},
+			want:        MakeArray(1, 2),
+			description: "iterator",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := FromAsync(context.Background(), tt.source)
+		TestLog("FromAsync", t, tt.source, got, tt.want, tt.description)
+		TestLog("FromAsync", t, tt.source, err, tt.err, tt.description+" error")
+	}
+}
+
+func TestArrayFromAsyncCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan interface{})
+	go func() {
+		ch <- "a"
+		ch <- "b"
+		cancel()
+	}()
+
+	got, err := FromAsync(ctx, ch)
+	TestLog("FromAsync", t, "cancel", got, MakeArray("a", "b"), "partial result on cancel")
+	TestLog("FromAsync", t, "cancel", err, context.Canceled, "cancel error")
+
+	_, err = FromAsync(context.Background(), 5)
+	_, ok := err.(*TypeError)
+	TestLog("FromAsync", t, 5, ok, true, "not async iterable")
+}
+
+func TestArrayToChannel(t *testing.T) {
+	arr := MakeArray(1, "a", true)
+	got := NewArray()
+	for v := range arr.ToChannel(context.Background(), 0) {
+		got.Push(v)
+	}
+	TestLog("ToChannel", t, arr, got, arr, "all elements sent")
+
+	long := NewArray()
+	for i := 0; i < 1000; i++ {
+		long.Push(i)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := long.ToChannel(ctx, 0)
+	<-ch
+	cancel()
+	received, closed := 0, false
+	timeout := time.After(time.Second)
+	for !closed {
+		select {
+		case _, ok := <-ch:
+			if ok {
+				received++
+			}
+			closed = !ok
+		case <-timeout:
+			t.Fatalf("ToChannel: channel isn't closed on cancel")
+		}
+	}
+	TestLog("ToChannel", t, received, received < len(long.Items)-1, true, "channel closed on cancel")
+
+	got, _ = FromAsync(context.Background(), MakeArray(1, 2, 3).ToChannel(context.Background(), 1))
+	TestLog("ToChannel", t, arr, got, MakeArray(1, 2, 3), "round trip with FromAsync")
+}
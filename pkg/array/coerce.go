@@ -0,0 +1,213 @@
+package array
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+// Coercible is element data with own js conversions, like value.Value
+type Coercible interface {
+	ToNumber() (float64, error)
+	ToString() (string, error)
+	ToBoolean() bool
+	ToBigInt() (*big.Int, error)
+	// Interface return go value of data; nil for null & undefined
+	Interface() interface{}
+}
+
+// Number return js number of data, like js Number(data)
+// 	strings are trimmed & parsed: "  12  " is 12, "" is 0, "0x1f" is 31; nil is 0
+// 	*big.Int is rounded, nested Array is converted by its string, other data is NaN
+func (i ArrayItem) Number() float64 {
+	if c, ok := i.Data.(Coercible); ok {
+		if n, ok := c.Interface().(*big.Int); ok {
+			f, _ := conv.ToNumber(n)
+			return f
+		}
+		f, err := c.ToNumber()
+		if err != nil {
+			return math.NaN()
+		}
+		return f
+	}
+	if arr, ok := i.Data.(*Array); ok {
+		return conv.StringToNumber(toString(arr))
+	}
+	if f, ok := conv.ToNumber(i.Data); ok {
+		return f
+	}
+	return math.NaN()
+}
+
+// String return js string of data, like js String(data)
+// 	nil is "null", numbers are formatted like js numbers, nested Array is joined by ","
+func (i ArrayItem) String() string {
+	return toString(i.Data)
+}
+
+// Bool return js boolean of data, like js Boolean(data)
+// 	false are nil, false, 0, NaN, 0n, empty string & nil pointers, maps & slices
+func (i ArrayItem) Bool() bool {
+	if c, ok := i.Data.(Coercible); ok {
+		return c.ToBoolean()
+	}
+	return conv.ToBoolean(i.Data)
+}
+
+// Int32 return Number of data wrapped to int32, like js ToInt32; NaN is 0
+func (i ArrayItem) Int32() int32 {
+	return conv.ToInt32(i.Number())
+}
+
+// Uint32 return Number of data wrapped to uint32, like js ToUint32; NaN is 0
+func (i ArrayItem) Uint32() uint32 {
+	return conv.ToUint32(i.Number())
+}
+
+// BigInt return js bigint of data, like js BigInt(data) or nil if js would throw, see AsBigInt
+func (i ArrayItem) BigInt() *big.Int {
+	n, _ := i.toBigInt()
+	return n
+}
+
+// toBigInt convert data like js BigInt(data)
+func (i ArrayItem) toBigInt() (*big.Int, error) {
+	d := i.Data
+	if c, ok := d.(Coercible); ok {
+		// numbers follow BigInt constructor, which is more tolerant than ToBigInt
+		f, ok := c.Interface().(float64)
+		if !ok {
+			return c.ToBigInt()
+		}
+		d = f
+	}
+
+	if n, ok := conv.Integer(d); ok {
+		return n, nil
+	}
+	if f, ok := conv.Number(d); ok {
+		if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+			return nil, &RangeError{Message: "The number " + conv.NumberToString(f) + " cannot be converted to a BigInt because it is not an integer"}
+		}
+		n, _ := big.NewFloat(f).Int(nil)
+		return n, nil
+	}
+	switch d := d.(type) {
+	case nil:
+		return nil, &TypeError{Message: "Cannot convert null to a BigInt"}
+	case bool:
+		if d {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	case *big.Int:
+		return new(big.Int).Set(d), nil
+	}
+	s := toString(d)
+	if n, ok := conv.StringToBigInt(s); ok {
+		return n, nil
+	}
+	return nil, &SyntaxError{Message: "Cannot convert " + s + " to a BigInt"}
+}
+
+// Array return nested Array of data or nil
+func (i ArrayItem) Array() *Array {
+	arr, _ := i.AsArray()
+	return arr
+}
+
+// unwrap return go value of Coercible data
+func (i ArrayItem) unwrap() interface{} {
+	if c, ok := i.Data.(Coercible); ok {
+		return c.Interface()
+	}
+	return i.Data
+}
+
+func typeError(d interface{}, want string) error {
+	if d == nil {
+		return &TypeError{Message: "null is not " + want}
+	}
+	return &TypeError{Message: fmt.Sprintf("%T is not %v", d, want)}
+}
+
+// AsNumber return data of go number type as float64 without coercion
+// 	return TypeError for other types
+func (i ArrayItem) AsNumber() (float64, error) {
+	d := i.unwrap()
+	if f, ok := conv.Number(d); ok {
+		return f, nil
+	}
+	return 0, typeError(d, "a number")
+}
+
+// AsString return string data without coercion
+// 	return TypeError for other types
+func (i ArrayItem) AsString() (string, error) {
+	d := i.unwrap()
+	if s, ok := d.(string); ok {
+		return s, nil
+	}
+	return "", typeError(d, "a string")
+}
+
+// AsBool return bool data without coercion
+// 	return TypeError for other types
+func (i ArrayItem) AsBool() (bool, error) {
+	d := i.unwrap()
+	if b, ok := d.(bool); ok {
+		return b, nil
+	}
+	return false, typeError(d, "a boolean")
+}
+
+// AsInt32 return integer number data as int32 without wrap-around
+// 	return TypeError for not number & RangeError for fraction or number out of int32 range
+func (i ArrayItem) AsInt32() (int32, error) {
+	f, err := i.asInteger(math.MinInt32, math.MaxInt32)
+	return int32(f), err
+}
+
+// AsUint32 return integer number data as uint32 without wrap-around
+// 	return TypeError for not number & RangeError for fraction or number out of uint32 range
+func (i ArrayItem) AsUint32() (uint32, error) {
+	f, err := i.asInteger(0, math.MaxUint32)
+	return uint32(f), err
+}
+
+func (i ArrayItem) asInteger(min, max float64) (float64, error) {
+	f, err := i.AsNumber()
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) || f < min || f > max {
+		return 0, &RangeError{Message: conv.NumberToString(f) + " is not integer in range [" + conv.NumberToString(min) + ", " + conv.NumberToString(max) + "]"}
+	}
+	return f, nil
+}
+
+// AsBigInt return *big.Int or integer data without coercion; result is copy
+// 	return TypeError for other types
+func (i ArrayItem) AsBigInt() (*big.Int, error) {
+	d := i.unwrap()
+	if n, ok := d.(*big.Int); ok && n != nil {
+		return new(big.Int).Set(n), nil
+	}
+	if n, ok := conv.Integer(d); ok {
+		return n, nil
+	}
+	return nil, typeError(d, "a bigint")
+}
+
+// AsArray return nested Array data
+// 	return TypeError for other types
+func (i ArrayItem) AsArray() (*Array, error) {
+	d := i.unwrap()
+	if arr, ok := d.(*Array); ok && arr != nil {
+		return arr, nil
+	}
+	return nil, typeError(d, "an Array")
+}
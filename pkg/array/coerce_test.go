@@ -0,0 +1,161 @@
+package array
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestArrayItemNumber(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        float64
+		description string
+	}{
+		{incoming: "  12  ", want: 12, description: "string is trimmed"},
+		{incoming: "", want: 0, description: "empty string is 0"},
+		{incoming: "0x1f", want: 31, description: "hex string"},
+		{incoming: "1e3", want: 1000, description: "exponent"},
+		{incoming: "12px", want: math.NaN(), description: "invalid string is NaN"},
+		{incoming: nil, want: 0, description: "nil is 0"},
+		{incoming: true, want: 1, description: "true is 1"},
+		{incoming: uint16(7), want: 7, description: "go integer"},
+		{incoming: float32(0.5), want: 0.5, description: "float32"},
+		{incoming: big.NewInt(10), want: 10, description: "bigint like js Number(10n)"},
+		{incoming: MakeArray(" 5 "), want: 5, description: "array of one element"},
+		{incoming: MakeArray(1, 2), want: math.NaN(), description: "array of two elements"},
+		{incoming: struct{}{}, want: math.NaN(), description: "object is NaN"},
+	}
+
+	for _, tt := range tests {
+		got := ArrayItem{Data: tt.incoming}.Number()
+		if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+			t.Errorf("TestArrayItemNumber:(%v) = %v, want %v. Test: %v", tt.incoming, got, tt.want, tt.description)
+		}
+	}
+}
+
+func TestArrayItemString(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        string
+		description string
+	}{
+		{incoming: nil, want: "null", description: "nil is null"},
+		{incoming: 1e21, want: "1e+21", description: "number like js"},
+		{incoming: math.Copysign(0, -1), want: "0", description: "-0"},
+		{incoming: MakeArray(1, nil, MakeArray(2, 3)), want: "1,,2,3", description: "nested array"},
+		{incoming: errors.New("boom"), want: "boom", description: "error"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestArrayItemString", t, tt.incoming, ArrayItem{Data: tt.incoming}.String(), tt.want, tt.description)
+	}
+}
+
+func TestArrayItemBool(t *testing.T) {
+	var nilMap map[string]int
+	tests := []struct {
+		incoming    interface{}
+		want        bool
+		description string
+	}{
+		{incoming: nil, want: false, description: "nil"},
+		{incoming: 0, want: false, description: "0"},
+		{incoming: math.NaN(), want: false, description: "NaN"},
+		{incoming: "", want: false, description: "empty string"},
+		{incoming: "0", want: true, description: "\"0\" is truthy"},
+		{incoming: new(big.Int), want: false, description: "0n"},
+		{incoming: nilMap, want: false, description: "nil map"},
+		{incoming: map[string]int{}, want: true, description: "empty map"},
+		{incoming: MakeArray(), want: true, description: "empty array"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestArrayItemBool", t, tt.incoming, ArrayItem{Data: tt.incoming}.Bool(), tt.want, tt.description)
+	}
+}
+
+func TestArrayItemInt32(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		int32       int32
+		uint32      uint32
+		description string
+	}{
+		{incoming: 2147483648.0, int32: -2147483648, uint32: 2147483648, description: "wrap-around"},
+		{incoming: -1, int32: -1, uint32: 4294967295, description: "negative"},
+		{incoming: 4294967297.5, int32: 1, uint32: 1, description: "fraction is truncated"},
+		{incoming: "-3.9", int32: -3, uint32: 4294967293, description: "string"},
+		{incoming: math.Inf(1), int32: 0, uint32: 0, description: "Infinity is 0"},
+		{incoming: "x", int32: 0, uint32: 0, description: "NaN is 0"},
+	}
+
+	for _, tt := range tests {
+		i := ArrayItem{Data: tt.incoming}
+		TestLog("TestArrayItemInt32", t, tt.incoming, i.Int32(), tt.int32, tt.description)
+		TestLog("TestArrayItemUint32", t, tt.incoming, i.Uint32(), tt.uint32, tt.description)
+	}
+}
+
+func TestArrayItemBigInt(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        *big.Int
+		err         error
+		description string
+	}{
+		{incoming: int64(math.MaxInt64), want: big.NewInt(math.MaxInt64), description: "int64 is exact"},
+		{incoming: 1e3, want: big.NewInt(1000), description: "integer float"},
+		{incoming: " 0x10 ", want: big.NewInt(16), description: "hex string"},
+		{incoming: true, want: big.NewInt(1), description: "true"},
+		{incoming: 1.5, err: &RangeError{Message: "The number 1.5 cannot be converted to a BigInt because it is not an integer"}, description: "fraction"},
+		{incoming: "1.5", err: &SyntaxError{Message: "Cannot convert 1.5 to a BigInt"}, description: "invalid string"},
+		{incoming: nil, err: &TypeError{Message: "Cannot convert null to a BigInt"}, description: "nil"},
+	}
+
+	for _, tt := range tests {
+		i := ArrayItem{Data: tt.incoming}
+		got, err := i.toBigInt()
+		TestLog("TestArrayItemBigInt", t, tt.incoming, got, tt.want, tt.description)
+		TestLog("TestArrayItemBigInt error", t, tt.incoming, err, tt.err, tt.description)
+		TestLog("TestArrayItemBigInt", t, tt.incoming, i.BigInt(), tt.want, tt.description)
+	}
+}
+
+func TestArrayItemArray(t *testing.T) {
+	nested := MakeArray(1)
+	TestLog("TestArrayItemArray", t, nested, ArrayItem{Data: nested}.Array(), nested, "nested array")
+	TestLog("TestArrayItemArray", t, "[1]", ArrayItem{Data: []int{1}}.Array(), (*Array)(nil), "go slice is not Array")
+}
+
+func TestArrayItemStrict(t *testing.T) {
+	n, err := ArrayItem{Data: int8(-4)}.AsNumber()
+	TestLog("TestArrayItemStrict", t, "int8(-4)", []interface{}{n, err}, []interface{}{-4.0, nil}, "number")
+	_, err = ArrayItem{Data: "12"}.AsNumber()
+	TestLog("TestArrayItemStrict", t, "\"12\"", err, error(&TypeError{Message: "string is not a number"}), "string is not coerced")
+	_, err = ArrayItem{}.AsString()
+	TestLog("TestArrayItemStrict", t, "nil", err, error(&TypeError{Message: "null is not a string"}), "nil")
+	s, err := ArrayItem{Data: "a"}.AsString()
+	TestLog("TestArrayItemStrict", t, "a", []interface{}{s, err}, []interface{}{"a", nil}, "string")
+	_, err = ArrayItem{Data: 1}.AsBool()
+	TestLog("TestArrayItemStrict", t, "1", err, error(&TypeError{Message: "int is not a boolean"}), "number is not bool")
+
+	i32, err := ArrayItem{Data: 2147483647.0}.AsInt32()
+	TestLog("TestArrayItemStrict", t, "2147483647", []interface{}{i32, err}, []interface{}{int32(2147483647), nil}, "max int32")
+	_, err = ArrayItem{Data: 2147483648.0}.AsInt32()
+	TestLog("TestArrayItemStrict", t, "2147483648", err, error(&RangeError{Message: "2147483648 is not integer in range [-2147483648, 2147483647]"}), "no wrap-around")
+	_, err = ArrayItem{Data: 1.5}.AsUint32()
+	TestLog("TestArrayItemStrict", t, "1.5", err, error(&RangeError{Message: "1.5 is not integer in range [0, 4294967295]"}), "fraction")
+	u32, err := ArrayItem{Data: uint8(200)}.AsUint32()
+	TestLog("TestArrayItemStrict", t, "200", []interface{}{u32, err}, []interface{}{uint32(200), nil}, "uint32")
+
+	b, err := ArrayItem{Data: 5}.AsBigInt()
+	TestLog("TestArrayItemStrict", t, "5", []interface{}{b, err}, []interface{}{big.NewInt(5), nil}, "integer is bigint")
+	_, err = ArrayItem{Data: 5.0}.AsBigInt()
+	TestLog("TestArrayItemStrict", t, "5.0", err, error(&TypeError{Message: "float64 is not a bigint"}), "float is not bigint")
+
+	_, err = ArrayItem{Data: []int{}}.AsArray()
+	TestLog("TestArrayItemStrict", t, "[]int", err, error(&TypeError{Message: "[]int is not an Array"}), "slice is not Array")
+}
@@ -0,0 +1,43 @@
+package array
+
+import (
+	"reflect"
+
+	"github.com/miron-developer/golang-js-utils/pkg/internal/hash"
+)
+
+// SameValueZero check is a and b same value, like js SameValueZero
+// 	NaN is equal to NaN, +0 is equal to -0
+// 	slices, maps, funcs & chans are compared by identity
+// 	values of different go types are never equal
+func SameValueZero(a, b interface{}) bool {
+	if hash.IsNaN(a) && hash.IsNaN(b) {
+		return true
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
+	if ta != tb {
+		return false
+	}
+	switch ta.Kind() {
+	case reflect.Slice:
+		va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+		return va.Pointer() == vb.Pointer() && va.Len() == vb.Len()
+	case reflect.Map, reflect.Func, reflect.Chan:
+		return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+	}
+	return safeEqual(a, b)
+}
+
+func safeEqual(a, b interface{}) (eq bool) {
+	// structs with uncomparable fields panic on ==
+	defer func() {
+		if recover() != nil {
+			eq = false
+		}
+	}()
+	return a == b
+}
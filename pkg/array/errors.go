@@ -0,0 +1,28 @@
+package array
+
+// TypeError is error of wrong value usage, like js TypeError
+type TypeError struct {
+	Message string
+}
+
+func (e *TypeError) Error() string {
+	return "TypeError: " + e.Message
+}
+
+// RangeError is error of value out of allowed range, like js RangeError
+type RangeError struct {
+	Message string
+}
+
+func (e *RangeError) Error() string {
+	return "RangeError: " + e.Message
+}
+
+// SyntaxError is error of unparsable string, like js SyntaxError
+type SyntaxError struct {
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return "SyntaxError: " + e.Message
+}
@@ -0,0 +1,59 @@
+package array
+
+import "github.com/miron-developer/golang-js-utils/pkg/internal/hash"
+
+// Group is one group of GroupByToMap with key as callback returned it
+type Group struct {
+	Key   interface{}
+	Items *Array
+}
+
+// GroupBy return elements grouped by callback key, like js Object.groupBy
+// 	key is coerced to string property key: 1 and "1" are same group
+// 	elements keep their order inside group; groups inherit semantics, like Filter
+func (a *Array) GroupBy(callback func(value ArrayItem, index int, array *Array) interface{}) map[string]*Array {
+	groups := map[string]*Array{}
+	for i, v := range a.Items {
+		key := toString(callback(v, i, a))
+		if groups[key] == nil {
+			groups[key] = a.derive(nil)
+		}
+		groups[key].Items = append(groups[key].Items, ArrayItem{Data: v.Data})
+	}
+	return groups
+}
+
+// GroupByToMap return elements grouped by callback key, like js Map.groupBy
+// 	keys are not coerced and compared by SameValueZero
+// 	groups are in order of first key appearance, elements keep their order inside group;
+// 	groups inherit semantics, like Filter
+func (a *Array) GroupByToMap(callback func(value ArrayItem, index int, array *Array) interface{}) []Group {
+	groups := []Group{}
+	index := map[interface{}]int{}
+	for i, v := range a.Items {
+		key := callback(v, i, a)
+		hk, hashable := hash.Key(key)
+
+		g, found := -1, false
+		if hashable {
+			g, found = index[hk]
+		} else {
+			for j := range groups {
+				if SameValueZero(groups[j].Key, key) {
+					g, found = j, true
+					break
+				}
+			}
+		}
+		if !found {
+			if hashable {
+				index[hk] = len(groups)
+			}
+			// -0 key is normalized to +0, like in js
+			groups = append(groups, Group{Key: hash.Zero(key), Items: a.derive(nil)})
+			g = len(groups) - 1
+		}
+		groups[g].Items.Items = append(groups[g].Items.Items, ArrayItem{Data: v.Data})
+	}
+	return groups
+}
@@ -0,0 +1,207 @@
+package array
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestArrayGroupBy(t *testing.T) {
+	tests := []struct {
+		incoming    []interface{}
+		key         func(value ArrayItem, index int, array *Array) interface{}
+		want        map[string]*Array
+		description string
+	}{
+		{
+			incoming: []interface{}{1, 2, 3, 4, 5},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				if value.Data.(int)%2 == 0 {
+					return "even"
+				}
+				return "odd"
+			},
+			want: map[string]*Array{
+				"even": MakeArray(2, 4),
+				"odd":  MakeArray(1, 3, 5),
+			},
+			description: "group by string key",
+		},
+		{
+			incoming: []interface{}{"a", "b", "c"},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				if index == 0 {
+					return 1
+				}
+				if index == 1 {
+					return "1"
+				}
+				return 1.5
+			},
+			want: map[string]*Array{
+				"1":   MakeArray("a", "b"),
+				"1.5": MakeArray("c"),
+			},
+			description: "number keys coerced to property key",
+		},
+		{
+			incoming: []interface{}{1, 2},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				return nil
+			},
+			want: map[string]*Array{
+				"null": MakeArray(1, 2),
+			},
+			description: "nil key",
+		},
+		{
+			incoming: []interface{}{},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				return 1
+			},
+			want:        map[string]*Array{},
+			description: "empty array",
+		},
+	}
+
+	for _, tt := range tests {
+		got := MakeArray(tt.incoming...).GroupBy(tt.key)
+		TestLog("GroupBy", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
+
+func TestArrayGroupByToMap(t *testing.T) {
+	slice := []int{1}
+	tests := []struct {
+		incoming    []interface{}
+		key         func(value ArrayItem, index int, array *Array) interface{}
+		want        []Group
+		description string
+	}{
+		{
+			incoming: []interface{}{3, 1, 2, 4},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				return value.Data.(int) % 2
+			},
+			want: []Group{
+				{Key: 1, Items: MakeArray(3, 1)},
+				{Key: 0, Items: MakeArray(2, 4)},
+			},
+			description: "insertion order of keys",
+		},
+		{
+			incoming: []interface{}{"a", "b", "c"},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				if index == 0 {
+					return 1
+				}
+				return "1"
+			},
+			want: []Group{
+				{Key: 1, Items: MakeArray("a")},
+				{Key: "1", Items: MakeArray("b", "c")},
+			},
+			description: "keys are not coerced",
+		},
+		{
+			incoming: []interface{}{"a", "b"},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				if index == 0 {
+					return math.Copysign(0, -1)
+				}
+				return 0.0
+			},
+			want: []Group{
+				{Key: 0.0, Items: MakeArray("a", "b")},
+			},
+			description: "-0 & +0 are same key",
+		},
+		{
+			incoming: []interface{}{"a", "b", "c"},
+			key: func(value ArrayItem, index int, array *Array) interface{} {
+				if index == 2 {
+					return []int{1}
+				}
+				return slice
+			},
+			want: []Group{
+				{Key: slice, Items: MakeArray("a", "b")},
+				{Key: []int{1}, Items: MakeArray("c")},
+			},
+			description: "slices are compared by identity",
+		},
+	}
+
+	for _, tt := range tests {
+		got := MakeArray(tt.incoming...).GroupByToMap(tt.key)
+		TestLog("GroupByToMap", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
+
+func TestArrayGroupByToMapNaN(t *testing.T) {
+	got := MakeArray("a", "b").GroupByToMap(func(value ArrayItem, index int, array *Array) interface{} {
+		return math.NaN()
+	})
+	// NaN key is not DeepEqual to itself
+	TestLog("GroupByToMap", t, "NaN", len(got), 1, "NaN keys are same key")
+	TestLog("GroupByToMap", t, "NaN", math.IsNaN(got[0].Key.(float64)), true, "NaN key is kept")
+	TestLog("GroupByToMap", t, "NaN", got[0].Items, MakeArray("a", "b"), "NaN group")
+}
+
+func TestSameValueZero(t *testing.T) {
+	slice := []int{1, 2}
+	m := map[string]int{}
+	tests := []struct {
+		a, b        interface{}
+		want        bool
+		description string
+	}{
+		{1, 1, true, "equal ints"},
+		{1, 1.0, false, "different go types"},
+		{math.NaN(), math.NaN(), true, "NaN"},
+		{0.0, math.Copysign(0, -1), true, "+0 & -0"},
+		{nil, nil, true, "nil"},
+		{nil, 0, false, "nil & zero"},
+		{slice, slice, true, "same slice"},
+		{slice, []int{1, 2}, false, "equal slices"},
+		{m, m, true, "same map"},
+		{struct{ s []int }{slice}, struct{ s []int }{slice}, false, "uncomparable structs"},
+	}
+
+	for _, tt := range tests {
+		got := SameValueZero(tt.a, tt.b)
+		TestLog("SameValueZero", t, []interface{}{tt.a, tt.b}, got, tt.want, tt.description)
+	}
+}
+
+// upperSemantics is test semantics which wrap strings in upper case
+type upperSemantics struct{}
+
+func (upperSemantics) Wrap(data interface{}) interface{} {
+	if s, ok := data.(string); ok {
+		return strings.ToUpper(s)
+	}
+	return data
+}
+func (upperSemantics) Undefined() interface{}                    { return "UNDEFINED" }
+func (upperSemantics) Nullish(data interface{}) bool             { return data == "UNDEFINED" }
+func (upperSemantics) ToString(data interface{}) (string, error) { return data.(string), nil }
+func (upperSemantics) StrictEqual(a, b interface{}) bool         { return a == b }
+func (upperSemantics) SameValueZero(a, b interface{}) bool       { return a == b }
+
+func TestArrayGroupSemantics(t *testing.T) {
+	a := MakeArray("a", "bb", "c").SetSemantics(upperSemantics{})
+	length := func(value ArrayItem, index int, array *Array) interface{} {
+		return len(value.Data.(string))
+	}
+
+	groups := a.GroupBy(length)
+	TestLog("GroupBy", t, "semantics", groups["1"].Semantics(), Semantics(upperSemantics{}), "group inherit semantics")
+	groups["1"].Push("d")
+	TestLog("GroupBy", t, "semantics", groups["1"].Join(","), "A,C,D", "group wrap pushed data")
+
+	byMap := a.GroupByToMap(length)
+	TestLog("GroupByToMap", t, "semantics", byMap[1].Items.Semantics(), Semantics(upperSemantics{}), "group inherit semantics")
+	byMap[1].Items.Push("e")
+	TestLog("GroupByToMap", t, "semantics", byMap[1].Items.Join(","), "BB,E", "group wrap pushed data")
+}
@@ -0,0 +1,129 @@
+package array
+
+type integrity int
+
+const (
+	extensible integrity = iota
+	nonExtensible
+	sealed
+	frozen
+)
+
+type operation int
+
+const (
+	opAdd operation = iota
+	opDelete
+	opAssign
+)
+
+// deny check is operation forbidden by integrity level
+// 	forbidden operation is recorded to Err or panic in strict mode
+func (a *Array) deny(op operation) bool {
+	if a.meta == nil {
+		return false
+	}
+
+	var err *TypeError
+	switch {
+	case op == opAdd && a.meta.integrity >= nonExtensible:
+		err = &TypeError{Message: "Cannot add property, object is not extensible"}
+	case op == opDelete && a.meta.integrity >= sealed:
+		err = &TypeError{Message: "Cannot delete property of sealed object"}
+	case op == opAssign && a.meta.integrity >= frozen:
+		err = &TypeError{Message: "Cannot assign to read only property of frozen object"}
+	default:
+		return false
+	}
+
+	a.fail(err)
+	return true
+}
+
+// fail record err to Err or panic with it in strict mode
+func (a *Array) fail(err error) {
+	m := a.state()
+	if m.strict {
+		panic(err)
+	}
+	m.err = err
+}
+
+func (a *Array) setIntegrity(level integrity) *Array {
+	m := a.state()
+	if m.integrity < level {
+		m.integrity = level
+	}
+	return a
+}
+
+// PreventExtensions forbid adding of elements, like js Object.preventExtensions
+func (a *Array) PreventExtensions() *Array {
+	return a.setIntegrity(nonExtensible)
+}
+
+// Seal forbid adding & removing of elements, like js Object.seal
+func (a *Array) Seal() *Array {
+	return a.setIntegrity(sealed)
+}
+
+// Freeze forbid any change of elements, like js Object.freeze
+// 	nested arrays are not frozen, use DeepFreeze for it
+// 	Items field is not protected from direct changes
+func (a *Array) Freeze() *Array {
+	return a.setIntegrity(frozen)
+}
+
+// DeepFreeze freeze array and all nested arrays
+func (a *Array) DeepFreeze() *Array {
+	return a.deepFreeze(map[*Array]bool{})
+}
+
+func (a *Array) deepFreeze(seen map[*Array]bool) *Array {
+	if seen[a] {
+		return a
+	}
+	seen[a] = true
+	a.Freeze()
+	for _, v := range a.Items {
+		if nested, ok := v.Data.(*Array); ok && nested != nil {
+			nested.deepFreeze(seen)
+		}
+	}
+	return a
+}
+
+// IsExtensible check can elements be added
+func (a *Array) IsExtensible() bool {
+	return a.meta == nil || a.meta.integrity == extensible
+}
+
+// IsSealed check is array sealed; empty not extensible array is sealed too
+func (a *Array) IsSealed() bool {
+	if a.meta == nil {
+		return false
+	}
+	return a.meta.integrity >= sealed || (a.meta.integrity == nonExtensible && len(a.Items) == 0)
+}
+
+// IsFrozen check is array frozen; empty not extensible array is frozen too
+func (a *Array) IsFrozen() bool {
+	if a.meta == nil {
+		return false
+	}
+	return a.meta.integrity == frozen || (a.meta.integrity >= nonExtensible && len(a.Items) == 0)
+}
+
+// SetStrict set strict mode: forbidden changes panic with *TypeError instead of recording it to Err
+func (a *Array) SetStrict(strict bool) *Array {
+	a.state().strict = strict
+	return a
+}
+
+// Err return *TypeError of last forbidden change or error of element conversion or nil
+func (a *Array) Err() error {
+	if a.meta == nil {
+		return nil
+	}
+	return a.meta.err
+}
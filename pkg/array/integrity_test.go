@@ -0,0 +1,145 @@
+package array
+
+import "testing"
+
+func TestArrayIntegrity(t *testing.T) {
+	tests := []struct {
+		lock        func(a *Array) *Array
+		change      func(a *Array)
+		want        *Array
+		denied      bool
+		description string
+	}{
+		{
+			lock:        (*Array).Freeze,
+			change:      func(a *Array) { a.Push(4) },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "push to frozen",
+		},
+		{
+			lock:        (*Array).Freeze,
+			change:      func(a *Array) { a.Fill(0) },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "fill frozen",
+		},
+		{
+			lock:        (*Array).Freeze,
+			change:      func(a *Array) { a.Reverse() },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "reverse frozen",
+		},
+		{
+			lock:        (*Array).Freeze,
+			change:      func(a *Array) { a.Shift() },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "shift frozen",
+		},
+		{
+			lock:        (*Array).Seal,
+			change:      func(a *Array) { a.Pop() },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "pop sealed",
+		},
+		{
+			lock:        (*Array).Seal,
+			change:      func(a *Array) { a.Reverse() },
+			want:        MakeArray(3, 2, 1),
+			description: "reverse sealed",
+		},
+		{
+			lock:        (*Array).PreventExtensions,
+			change:      func(a *Array) { a.Unshift(0) },
+			want:        MakeArray(1, 2, 3),
+			denied:      true,
+			description: "unshift not extensible",
+		},
+		{
+			lock:        (*Array).PreventExtensions,
+			change:      func(a *Array) { a.Slice(1, 3) },
+			want:        MakeArray(2, 3),
+			description: "slice not extensible",
+		},
+	}
+
+	for _, tt := range tests {
+		arr := tt.lock(MakeArray(1, 2, 3))
+		tt.change(arr)
+		TestLog("Integrity", t, tt.description, arr.Items, tt.want.Items, tt.description)
+		_, denied := arr.Err().(*TypeError)
+		TestLog("Integrity", t, tt.description, denied, tt.denied, tt.description+" error")
+	}
+}
+
+func TestArrayIntegrityStatus(t *testing.T) {
+	tests := []struct {
+		arr         *Array
+		want        []bool
+		description string
+	}{
+		{
+			arr:         MakeArray(1),
+			want:        []bool{true, false, false},
+			description: "new array",
+		},
+		{
+			arr:         MakeArray(1).PreventExtensions(),
+			want:        []bool{false, false, false},
+			description: "not extensible",
+		},
+		{
+			arr:         MakeArray().PreventExtensions(),
+			want:        []bool{false, true, true},
+			description: "empty not extensible",
+		},
+		{
+			arr:         MakeArray(1).Seal(),
+			want:        []bool{false, true, false},
+			description: "sealed",
+		},
+		{
+			arr:         MakeArray(1).Freeze(),
+			want:        []bool{false, true, true},
+			description: "frozen",
+		},
+		{
+			arr:         MakeArray(1).Freeze().PreventExtensions(),
+			want:        []bool{false, true, true},
+			description: "integrity is not lowered",
+		},
+	}
+
+	for _, tt := range tests {
+		got := []bool{tt.arr.IsExtensible(), tt.arr.IsSealed(), tt.arr.IsFrozen()}
+		TestLog("IntegrityStatus", t, tt.arr.Items, got, tt.want, tt.description)
+	}
+}
+
+func TestArrayStrict(t *testing.T) {
+	arr := MakeArray(1).Freeze().SetStrict(true)
+	defer func() {
+		_, ok := recover().(*TypeError)
+		TestLog("Strict", t, arr.Items, ok, true, "panic in strict mode")
+	}()
+	arr.Push(2)
+	t.Error("Strict: push to frozen array don't panic")
+}
+
+func TestArrayDeepFreeze(t *testing.T) {
+	nested := MakeArray(1)
+	arr := MakeArray(nested, MakeArray(MakeArray(2)))
+	arr.Push(arr)
+	arr.DeepFreeze()
+
+	nested.Push(2)
+	TestLog("DeepFreeze", t, "nested", nested.Items, MakeArray(1).Items, "nested array is frozen")
+	deep := arr.Items[1].Data.(*Array).Items[0].Data.(*Array)
+	TestLog("DeepFreeze", t, "deep", deep.IsFrozen(), true, "deep nested array is frozen")
+
+	shallow := MakeArray(MakeArray(1)).Freeze()
+	TestLog("Freeze", t, "shallow", shallow.Items[0].Data.(*Array).IsFrozen(), false, "freeze is shallow")
+}
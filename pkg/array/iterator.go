@@ -0,0 +1,19 @@
+package array
+
+// Iterator is source of values, like js iterator protocol
+type Iterator interface {
+	// Next return next value; done is true when iterator is exhausted
+	Next() (value interface{}, done bool)
+}
+
+// FromIterator return new Array with all values taken from iterator
+func FromIterator(it Iterator) *Array {
+	r := NewArray()
+	for {
+		v, done := it.Next()
+		if done {
+			return r
+		}
+		r.Push(v)
+	}
+}
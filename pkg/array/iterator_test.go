@@ -0,0 +1,39 @@
+package array
+
+import "testing"
+
+type countIterator struct {
+	i, n int
+}
+
+func (c *countIterator) Next() (interface{}, bool) {
+	if c.i >= c.n {
+		return nil, true
+	}
+	c.i++
+	return c.i, false
+}
+
+func TestArrayFromIterator(t *testing.T) {
+	tests := []struct {
+		incoming    int
+		want        *Array
+		description string
+	}{
+		{
+			incoming:    3,
+			want:        &Array{Items: []ArrayItem{{Data: 1}, {Data: 2}, {Data: 3}}},
+			description: "3 values",
+		},
+		{
+			incoming:    0,
+			want:        &Array{},
+			description: "exhausted iterator",
+		},
+	}
+
+	for _, tt := range tests {
+		got := FromIterator(&countIterator{n: tt.incoming})
+		TestLog("FromIterator", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
@@ -0,0 +1,20 @@
+package array
+
+// meta is Array state besides items
+type meta struct {
+	integrity integrity
+	strict    bool
+	err       error
+	semantics Semantics
+
+	observers []*observer
+	batch     int
+	pending   []ChangeRecord
+}
+
+func (a *Array) state() *meta {
+	if a.meta == nil {
+		a.meta = &meta{}
+	}
+	return a.meta
+}
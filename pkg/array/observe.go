@@ -0,0 +1,98 @@
+package array
+
+// ChangeType is type of ChangeRecord
+type ChangeType string
+
+const (
+	// ChangeSplice is adding or removing of elements
+	ChangeSplice ChangeType = "splice"
+	// ChangeUpdate is change of element data
+	ChangeUpdate ChangeType = "update"
+)
+
+// ChangeRecord describe one array change, like records of js Array.observe
+// 	splice: Removed elements at Index replaced by AddedCount new elements
+// 	update: element at Index changed, OldValue is previous data
+type ChangeRecord struct {
+	Type       ChangeType
+	Object     *Array
+	Index      int
+	Removed    []ArrayItem
+	AddedCount int
+	OldValue   interface{}
+}
+
+type observer struct {
+	callback func(records []ChangeRecord)
+}
+
+// Observe subscribe callback to array changes; return unsubscribe function
+// 	callback is called synchronously after every change, or once after Batch
+func (a *Array) Observe(callback func(records []ChangeRecord)) (unobserve func()) {
+	m := a.state()
+	o := &observer{callback: callback}
+	m.observers = append(m.observers, o)
+	return func() {
+		for i, v := range m.observers {
+			if v == o {
+				m.observers = append(m.observers[:i:i], m.observers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Batch run fn and deliver all changes made in it as one notification
+func (a *Array) Batch(fn func()) {
+	m := a.state()
+	m.batch++
+	defer func() {
+		m.batch--
+		if m.batch == 0 && len(m.pending) > 0 {
+			records := m.pending
+			m.pending = nil
+			a.deliver(records)
+		}
+	}()
+	fn()
+}
+
+func (a *Array) observed() bool {
+	return a.meta != nil && len(a.meta.observers) > 0
+}
+
+// notify send change records to observers; every mutation must call it
+func (a *Array) notify(records ...ChangeRecord) {
+	if !a.observed() || len(records) == 0 {
+		return
+	}
+	for i := range records {
+		records[i].Object = a
+	}
+	if a.meta.batch > 0 {
+		a.meta.pending = append(a.meta.pending, records...)
+		return
+	}
+	a.deliver(records)
+}
+
+func (a *Array) deliver(records []ChangeRecord) {
+	observers := append([]*observer{}, a.meta.observers...)
+	for _, o := range observers {
+		o.callback(records)
+	}
+}
+
+// reorderRecord return splice record of whole array for Sort & Reverse
+func (a *Array) reorderRecord() []ChangeRecord {
+	if !a.observed() || len(a.Items) < 2 {
+		return nil
+	}
+	return []ChangeRecord{{Type: ChangeSplice, Index: 0, Removed: copyItems(a.Items), AddedCount: len(a.Items)}}
+}
+
+func copyItems(items []ArrayItem) []ArrayItem {
+	r := make([]ArrayItem, len(items))
+	copy(r, items)
+	return r
+}
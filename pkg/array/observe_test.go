@@ -0,0 +1,117 @@
+package array
+
+import "testing"
+
+func TestArrayObserve(t *testing.T) {
+	tests := []struct {
+		change      func(a *Array)
+		want        []ChangeRecord
+		description string
+	}{
+		{
+			change:      func(a *Array) { a.Push(4, 5) },
+			want:        []ChangeRecord{{Type: ChangeSplice, Index: 3, AddedCount: 2}},
+			description: "push",
+		},
+		{
+			change:      func(a *Array) { a.Unshift(0) },
+			want:        []ChangeRecord{{Type: ChangeSplice, Index: 0, AddedCount: 1}},
+			description: "unshift",
+		},
+		{
+			change:      func(a *Array) { a.Pop() },
+			want:        []ChangeRecord{{Type: ChangeSplice, Index: 2, Removed: []ArrayItem{{Data: 3}}}},
+			description: "pop",
+		},
+		{
+			change:      func(a *Array) { a.Shift() },
+			want:        []ChangeRecord{{Type: ChangeSplice, Index: 0, Removed: []ArrayItem{{Data: 1}}}},
+			description: "shift",
+		},
+		{
+			change: func(a *Array) { a.Slice(1, 2) },
+			want: []ChangeRecord{
+				{Type: ChangeSplice, Index: 2, Removed: []ArrayItem{{Data: 3}}},
+				{Type: ChangeSplice, Index: 0, Removed: []ArrayItem{{Data: 1}}},
+			},
+			description: "slice",
+		},
+		{
+			change: func(a *Array) { a.Fill(2) },
+			want: []ChangeRecord{
+				{Type: ChangeUpdate, Index: 0, OldValue: 1},
+				{Type: ChangeUpdate, Index: 2, OldValue: 3},
+			},
+			description: "fill",
+		},
+		{
+			change: func(a *Array) { a.Reverse() },
+			want: []ChangeRecord{
+				{Type: ChangeSplice, Index: 0, Removed: []ArrayItem{{Data: 1}, {Data: 2}, {Data: 3}}, AddedCount: 3},
+			},
+			description: "reverse",
+		},
+		{
+			change: func(a *Array) {
+				a.Sort(func(a, b ArrayItem) int { return b.Data.(int) - a.Data.(int) })
+			},
+			want: []ChangeRecord{
+				{Type: ChangeSplice, Index: 0, Removed: []ArrayItem{{Data: 1}, {Data: 2}, {Data: 3}}, AddedCount: 3},
+			},
+			description: "sort",
+		},
+		{
+			change:      func(a *Array) { a.Push().Unshift() },
+			want:        nil,
+			description: "push & unshift without items",
+		},
+		{
+			change:      func(a *Array) { a.Freeze().Push(4) },
+			want:        nil,
+			description: "forbidden change",
+		},
+	}
+
+	for _, tt := range tests {
+		arr := MakeArray(1, 2, 3)
+		var got []ChangeRecord
+		arr.Observe(func(records []ChangeRecord) {
+			got = append(got, records...)
+		})
+		tt.change(arr)
+		for i := range got {
+			if got[i].Object != arr {
+				t.Errorf("Observe: record object is not observed array. Test: %v", tt.description)
+			}
+			got[i].Object = nil
+		}
+		TestLog("Observe", t, tt.description, got, tt.want, tt.description)
+	}
+}
+
+func TestArrayUnobserve(t *testing.T) {
+	arr := MakeArray()
+	calls := 0
+	unobserve := arr.Observe(func(records []ChangeRecord) { calls++ })
+	arr.Push(1)
+	unobserve()
+	arr.Push(2)
+	TestLog("Unobserve", t, "push", calls, 1, "no notifications after unobserve")
+}
+
+func TestArrayBatch(t *testing.T) {
+	arr := MakeArray(1)
+	var notifications [][]ChangeRecord
+	arr.Observe(func(records []ChangeRecord) {
+		notifications = append(notifications, records)
+	})
+	arr.Batch(func() {
+		arr.Push(2)
+		arr.Batch(func() {
+			arr.Shift()
+		})
+		TestLog("Batch", t, "inside", len(notifications), 0, "no notifications inside batch")
+	})
+	TestLog("Batch", t, "after", len(notifications), 1, "one notification")
+	TestLog("Batch", t, "after", len(notifications[0]), 2, "all records in notification")
+}
@@ -0,0 +1,105 @@
+package array
+
+// Semantics define how Array treats element data
+// 	by default data is stored as is, compared with == & converted to string like go values;
+// 	value package provides js semantics where every element is value.Value
+type Semantics interface {
+	// Wrap return element data of data added by Push, Unshift, Fill & SetAt
+	Wrap(data interface{}) interface{}
+	// Undefined return element data of holes made by SetAt & SetLength, like js undefined
+	Undefined() interface{}
+	// Nullish check is element null or undefined; such elements are empty strings in Join
+	Nullish(data interface{}) bool
+	// ToString return string of element for Join & default Sort
+	ToString(data interface{}) (string, error)
+	// StrictEqual is equality of IndexOf & LastIndexOf, like js ===
+	StrictEqual(a, b interface{}) bool
+	// SameValueZero is equality of Includes & change detection of observers
+	SameValueZero(a, b interface{}) bool
+}
+
+// SetSemantics set semantics of elements; nil restore default one
+// 	existing elements are wrapped, nil elements become undefined
+// 	arrays made by NewSlice, Filter & Map inherit semantics
+// 	error of element conversion is recorded to Err or panic in strict mode
+func (a *Array) SetSemantics(s Semantics) *Array {
+	a.state().semantics = s
+	if s == nil {
+		return a
+	}
+	for i, v := range a.Items {
+		if v.Data == nil {
+			a.Items[i].Data = s.Undefined()
+		} else {
+			a.Items[i].Data = s.Wrap(v.Data)
+		}
+	}
+	return a
+}
+
+// Semantics return semantics set by SetSemantics or nil
+func (a *Array) Semantics() Semantics {
+	if a.meta == nil {
+		return nil
+	}
+	return a.meta.semantics
+}
+
+// derive return new array with items & semantics of a
+func (a *Array) derive(items []ArrayItem) *Array {
+	r := &Array{Items: items}
+	if s := a.Semantics(); s != nil {
+		r.state().semantics = s
+	}
+	return r
+}
+
+func (a *Array) wrap(data interface{}) interface{} {
+	if s := a.Semantics(); s != nil {
+		return s.Wrap(data)
+	}
+	return data
+}
+
+// hole return element of hole
+func (a *Array) hole() ArrayItem {
+	if s := a.Semantics(); s != nil {
+		return ArrayItem{Data: s.Undefined()}
+	}
+	return ArrayItem{}
+}
+
+func (a *Array) strictEqual(x, y interface{}) bool {
+	if s := a.Semantics(); s != nil {
+		return s.StrictEqual(x, y)
+	}
+	return x == y
+}
+
+func (a *Array) sameValueZero(x, y interface{}) bool {
+	if s := a.Semantics(); s != nil {
+		return s.SameValueZero(x, y)
+	}
+	return SameValueZero(x, y)
+}
+
+// undefined check is element last in default sort
+func (a *Array) undefined(data interface{}) bool {
+	if s := a.Semantics(); s != nil {
+		return s.SameValueZero(data, s.Undefined())
+	}
+	return data == nil
+}
+
+// elementString return string of element for Join & default Sort
+func (a *Array) elementString(data interface{}) string {
+	s := a.Semantics()
+	if s == nil {
+		return toString(data)
+	}
+	str, err := s.ToString(data)
+	if err != nil {
+		a.fail(err)
+	}
+	return str
+}
@@ -0,0 +1,70 @@
+package array
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// toString return js string of item data; nested Array is joined by ","
+func toString(v interface{}) string {
+	if arr, ok := v.(*Array); ok {
+		return arr.join(",", map[*Array]bool{})
+	}
+	return conv.ToString(v)
+}
+
+// join return elements joined by separator; cyclic array is empty string, like in js
+func (a *Array) join(separator string, seen map[*Array]bool) string {
+	if seen[a] {
+		return ""
+	}
+	seen[a] = true
+	defer delete(seen, a)
+
+	parts := make([]string, len(a.Items))
+	sem := a.Semantics()
+	for i, v := range a.Items {
+		switch d := v.Data.(type) {
+		case nil:
+		case *Array:
+			parts[i] = d.join(",", seen)
+		default:
+			if sem == nil || !sem.Nullish(d) {
+				parts[i] = a.elementString(d)
+			}
+		}
+	}
+	return strings.Join(parts, separator)
+}
+
+// defaultSort sort items like js default sort
+func (a *Array) defaultSort() {
+	type keyed struct {
+		item      ArrayItem
+		key       jsstring.JSString
+		undefined bool
+	}
+	ks := make([]keyed, len(a.Items))
+	for i, v := range a.Items {
+		ks[i].item = v
+		ks[i].undefined = a.undefined(v.Data)
+		if !ks[i].undefined {
+			ks[i].key = jsstring.New(a.elementString(v.Data))
+		}
+	}
+	sort.SliceStable(ks, func(i, j int) bool {
+		if ks[i].undefined {
+			return false
+		}
+		if ks[j].undefined {
+			return true
+		}
+		return jsstring.Compare(ks[i].key, ks[j].key) < 0
+	})
+	for i := range ks {
+		a.Items[i] = ks[i].item
+	}
+}
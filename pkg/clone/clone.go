@@ -0,0 +1,349 @@
+package clone
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/set"
+	"github.com/miron-developer/golang-js-utils/pkg/typedarray"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+// StructuredClone return deep copy of v, like js structuredClone
+// 	value referenced twice is cloned once, so shared references & cycles are kept in clone
+// 	supported are *array.Array, *jsmap.Map, *set.Set, value.Value, typed arrays, maps, slices,
+// 	arrays, pointers, structs & primitives; time.Time & shared ArrayBuffer are kept as is
+// 	Array keeps semantics, but not integrity level & observers, like js clone is not frozen
+// 	unexported struct fields are copied as is, like js private state is not cloned
+// 	slices are same reference only if they have same start & length
+// 	return DataCloneError for funcs, chans, unsafe pointers & symbols
+func StructuredClone[T any](v T) (T, error) {
+	c := &cloner{memo: map[ref]reflect.Value{}}
+	rv := reflect.ValueOf(&v).Elem()
+	r, err := c.clone(rv)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	// nil interface of T is zero T
+	out, _ := r.Interface().(T)
+	return out, nil
+}
+
+// ref is identity of referenced value
+type ref struct {
+	t   reflect.Type
+	ptr uintptr
+	len int
+}
+
+type cloner struct {
+	memo map[ref]reflect.Value
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	locationType = reflect.TypeOf(&time.Location{})
+	valueType    = reflect.TypeOf(value.Value{})
+)
+
+func (c *cloner) clone(v reflect.Value) (reflect.Value, error) {
+	switch v.Type() {
+	case timeType, locationType:
+		return v, nil
+	case valueType:
+		return c.cloneValue(v.Interface().(value.Value))
+	}
+
+	switch v.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() && v.Kind() != reflect.UnsafePointer {
+			return v, nil
+		}
+		return v, &DataCloneError{Message: fmt.Sprintf("%v could not be cloned", v.Type())}
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+		e, err := c.clone(v.Elem())
+		if err != nil {
+			return v, err
+		}
+		r := reflect.New(v.Type()).Elem()
+		r.Set(e)
+		return r, nil
+	case reflect.Ptr:
+		return c.clonePtr(v)
+	case reflect.Map:
+		return c.cloneMap(v)
+	case reflect.Slice:
+		return c.cloneSlice(v)
+	case reflect.Array:
+		r := reflect.New(v.Type()).Elem()
+		return r, c.cloneElems(r, v)
+	case reflect.Struct:
+		return c.cloneStruct(v)
+	}
+	return v, nil
+}
+
+// known return clone of already cloned reference
+func (c *cloner) known(v reflect.Value, len int) (ref, reflect.Value, bool) {
+	key := ref{t: v.Type(), ptr: v.Pointer(), len: len}
+	r, ok := c.memo[key]
+	return key, r, ok
+}
+
+func (c *cloner) clonePtr(v reflect.Value) (reflect.Value, error) {
+	if v.IsNil() {
+		return v, nil
+	}
+	key, r, ok := c.known(v, 0)
+	if ok {
+		return r, nil
+	}
+
+	switch p := v.Interface().(type) {
+	case *array.Array:
+		return c.cloneArray(key, p)
+	case *jsmap.Map:
+		return c.cloneMapObject(key, p)
+	case *set.Set:
+		return c.cloneSet(key, p)
+	case *typedarray.ArrayBuffer:
+		return c.cloneBuffer(key, p)
+	case *typedarray.TypedArray:
+		return c.cloneTypedArray(key, p)
+	case *typedarray.DataView:
+		return c.cloneDataView(key, p)
+	}
+
+	r = reflect.New(v.Type().Elem())
+	c.memo[key] = r
+	e, err := c.clone(v.Elem())
+	if err != nil {
+		return v, err
+	}
+	r.Elem().Set(e)
+	return r, nil
+}
+
+func (c *cloner) cloneMap(v reflect.Value) (reflect.Value, error) {
+	if v.IsNil() {
+		return v, nil
+	}
+	key, r, ok := c.known(v, 0)
+	if ok {
+		return r, nil
+	}
+
+	r = reflect.MakeMapWithSize(v.Type(), v.Len())
+	c.memo[key] = r
+	for it := v.MapRange(); it.Next(); {
+		k, err := c.clone(it.Key())
+		if err != nil {
+			return v, err
+		}
+		e, err := c.clone(it.Value())
+		if err != nil {
+			return v, err
+		}
+		r.SetMapIndex(k, e)
+	}
+	return r, nil
+}
+
+func (c *cloner) cloneSlice(v reflect.Value) (reflect.Value, error) {
+	if v.IsNil() {
+		return v, nil
+	}
+	key, r, ok := c.known(v, v.Len())
+	if ok {
+		return r, nil
+	}
+
+	r = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	c.memo[key] = r
+	if plain(v.Type().Elem()) {
+		// typed byte slices & other slices without references
+		reflect.Copy(r, v)
+		return r, nil
+	}
+	return r, c.cloneElems(r, v)
+}
+
+// plain check is value of type t copied without references
+func plain(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.String:
+		return true
+	}
+	return false
+}
+
+// cloneElems set elements of array or slice r to clones of elements of v
+func (c *cloner) cloneElems(r, v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		e, err := c.clone(v.Index(i))
+		if err != nil {
+			return err
+		}
+		r.Index(i).Set(e)
+	}
+	return nil
+}
+
+func (c *cloner) cloneStruct(v reflect.Value) (reflect.Value, error) {
+	r := reflect.New(v.Type()).Elem()
+	r.Set(v)
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		e, err := c.clone(v.Field(i))
+		if err != nil {
+			return v, err
+		}
+		r.Field(i).Set(e)
+	}
+	return r, nil
+}
+
+func (c *cloner) cloneArray(key ref, arr *array.Array) (reflect.Value, error) {
+	r := array.NewArray()
+	c.memo[key] = reflect.ValueOf(r)
+	r.Items = make([]array.ArrayItem, len(arr.Items))
+	for i, item := range arr.Items {
+		d, err := c.cloneInterface(item.Data)
+		if err != nil {
+			return key.zero(), err
+		}
+		r.Items[i].Data = d
+	}
+	if s := arr.Semantics(); s != nil {
+		r.SetSemantics(s)
+	}
+	return reflect.ValueOf(r), nil
+}
+
+func (c *cloner) cloneMapObject(key ref, m *jsmap.Map) (reflect.Value, error) {
+	r := jsmap.New()
+	c.memo[key] = reflect.ValueOf(r)
+	var err error
+	m.ForEach(func(v, k interface{}, _ *jsmap.Map) {
+		var ck, cv interface{}
+		if err == nil {
+			ck, err = c.cloneInterface(k)
+		}
+		if err == nil {
+			cv, err = c.cloneInterface(v)
+		}
+		if err == nil {
+			r.Set(ck, cv)
+		}
+	})
+	return reflect.ValueOf(r), err
+}
+
+func (c *cloner) cloneSet(key ref, s *set.Set) (reflect.Value, error) {
+	r := set.New()
+	c.memo[key] = reflect.ValueOf(r)
+	var err error
+	s.ForEach(func(v, _ interface{}, _ *set.Set) {
+		var cv interface{}
+		if err == nil {
+			cv, err = c.cloneInterface(v)
+		}
+		if err == nil {
+			r.Add(cv)
+		}
+	})
+	return reflect.ValueOf(r), err
+}
+
+// cloneInterface return clone of go value of any type
+func (c *cloner) cloneInterface(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	r, err := c.clone(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return r.Interface(), nil
+}
+
+func (c *cloner) cloneValue(v value.Value) (reflect.Value, error) {
+	switch v.Kind() {
+	case value.KindSymbol:
+		return reflect.ValueOf(v), &DataCloneError{Message: v.String() + " could not be cloned"}
+	case value.KindObject, value.KindArray:
+		r, err := c.cloneInterface(v.Interface())
+		if err != nil {
+			return reflect.ValueOf(v), err
+		}
+		if v.Kind() == value.KindArray {
+			return reflect.ValueOf(value.Array(r.(*array.Array))), nil
+		}
+		return reflect.ValueOf(value.Object(r)), nil
+	}
+	return reflect.ValueOf(v), nil
+}
+
+func (c *cloner) cloneBuffer(key ref, b *typedarray.ArrayBuffer) (reflect.Value, error) {
+	if b.IsShared() {
+		// shared memory is shared by clone too, like in js
+		return reflect.ValueOf(b), nil
+	}
+	r := typedarray.NewArrayBuffer(b.ByteLength())
+	if b.Resizable() {
+		r, _ = typedarray.NewResizableArrayBuffer(b.ByteLength(), b.MaxByteLength())
+	}
+	copy(r.Bytes(), b.Bytes())
+	c.memo[key] = reflect.ValueOf(r)
+	return reflect.ValueOf(r), nil
+}
+
+func (c *cloner) cloneTypedArray(key ref, t *typedarray.TypedArray) (reflect.Value, error) {
+	b, err := c.clone(reflect.ValueOf(t.Buffer()))
+	if err != nil {
+		return key.zero(), err
+	}
+	length := t.Length()
+	if t.LengthTracking() {
+		length = -1
+	}
+	r, err := typedarray.NewView(t.Kind(), b.Interface().(*typedarray.ArrayBuffer), t.ByteOffset(), length)
+	if err != nil {
+		return key.zero(), err
+	}
+	c.memo[key] = reflect.ValueOf(r)
+	return reflect.ValueOf(r), nil
+}
+
+func (c *cloner) cloneDataView(key ref, d *typedarray.DataView) (reflect.Value, error) {
+	b, err := c.clone(reflect.ValueOf(d.Buffer()))
+	if err != nil {
+		return key.zero(), err
+	}
+	length := d.ByteLength()
+	if d.LengthTracking() {
+		length = -1
+	}
+	r, err := typedarray.NewDataView(b.Interface().(*typedarray.ArrayBuffer), d.ByteOffset(), length)
+	if err != nil {
+		return key.zero(), err
+	}
+	c.memo[key] = reflect.ValueOf(r)
+	return reflect.ValueOf(r), nil
+}
+
+// zero return nil of referenced type
+func (k ref) zero() reflect.Value {
+	return reflect.Zero(k.t)
+}
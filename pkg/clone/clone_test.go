@@ -0,0 +1,190 @@
+package clone
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/set"
+	"github.com/miron-developer/golang-js-utils/pkg/typedarray"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+type Node struct {
+	Name     string
+	Children []*Node
+	Parent   *Node
+	Meta     map[string]interface{}
+	Created  time.Time
+	secret   *int
+}
+
+type Bytes []byte
+
+func TestStructuredCloneDeep(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		description string
+	}{
+		{incoming: 1.5, description: "primitive"},
+		{incoming: "str", description: "string"},
+		{incoming: big.NewInt(12), description: "big.Int"},
+		{incoming: map[string]interface{}{"a": []interface{}{1, "b", map[int]bool{1: true}}}, description: "nested map & slice"},
+		{incoming: array.MakeArray(1, array.MakeArray(2, 3), map[string]int{"x": 1}), description: "nested Array"},
+		{incoming: [2][]int{{1}, {2, 3}}, description: "go array"},
+		{incoming: Bytes("bytes"), description: "typed byte slice"},
+		{incoming: &Node{Name: "n", Meta: map[string]interface{}{"k": 1}, Created: time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)}, description: "pointer to struct"},
+		{incoming: []int(nil), description: "nil slice is kept"},
+		{incoming: nil, description: "nil interface"},
+	}
+
+	for _, tt := range tests {
+		got, err := StructuredClone(tt.incoming)
+		TestLog("TestStructuredCloneDeep", t, tt.incoming, got, tt.incoming, tt.description)
+		TestLog("TestStructuredCloneDeep error", t, tt.incoming, err, nil, tt.description)
+	}
+}
+
+func TestStructuredCloneIndependent(t *testing.T) {
+	inner := []int{1, 2}
+	m := map[string]interface{}{"inner": inner, "arr": array.MakeArray(1)}
+	got, _ := StructuredClone(m)
+
+	inner[0] = 100
+	m["arr"].(*array.Array).Push(2)
+	TestLog("TestStructuredCloneIndependent", t, m, got["inner"], []int{1, 2}, "slice is copied")
+	TestLog("TestStructuredCloneIndependent", t, m, len(got["arr"].(*array.Array).Items), 1, "Array is copied")
+
+	b := Bytes("ab")
+	cb, _ := StructuredClone(b)
+	b[0] = 'x'
+	TestLog("TestStructuredCloneIndependent", t, b, string(cb), "ab", "byte slice is copied")
+}
+
+func TestStructuredCloneShared(t *testing.T) {
+	shared := map[string]int{"a": 1}
+	arr := array.MakeArray(shared, shared)
+	got, _ := StructuredClone(arr)
+	a, b := got.Items[0].Data.(map[string]int), got.Items[1].Data.(map[string]int)
+	a["a"] = 2
+	TestLog("TestStructuredCloneShared", t, arr, b["a"], 2, "same map is cloned once")
+	TestLog("TestStructuredCloneShared", t, arr, shared["a"], 1, "original is not changed")
+
+	slice := []string{"x"}
+	pair, _ := StructuredClone([]interface{}{slice, slice, slice[:0]})
+	pair[0].([]string)[0] = "y"
+	TestLog("TestStructuredCloneShared", t, pair, pair[1].([]string)[0], "y", "same slice is cloned once")
+	TestLog("TestStructuredCloneShared", t, pair, len(pair[2].([]string)), 0, "slice of other length is other reference")
+}
+
+func TestStructuredCloneCycle(t *testing.T) {
+	root := &Node{Name: "root"}
+	child := &Node{Name: "child", Parent: root}
+	root.Children = []*Node{child}
+	got, err := StructuredClone(root)
+	TestLog("TestStructuredCloneCycle", t, "root", err, nil, "no error")
+	TestLog("TestStructuredCloneCycle", t, "root", got.Children[0].Parent == got, true, "cycle points to clone")
+	TestLog("TestStructuredCloneCycle", t, "root", got != root && got.Children[0] != child, true, "nodes are new")
+
+	arr := array.MakeArray(1)
+	arr.Push(arr)
+	carr, _ := StructuredClone(arr)
+	TestLog("TestStructuredCloneCycle", t, "arr", carr.Items[1].Data == carr, true, "cyclic Array")
+
+	m := map[string]interface{}{}
+	m["self"] = m
+	cm, _ := StructuredClone(m)
+	cm["x"] = 1
+	TestLog("TestStructuredCloneCycle", t, "map", cm["self"].(map[string]interface{})["x"], 1, "cyclic map")
+}
+
+func TestStructuredCloneUnexported(t *testing.T) {
+	n := 1
+	node := &Node{secret: &n}
+	got, _ := StructuredClone(node)
+	TestLog("TestStructuredCloneUnexported", t, node, got.secret == &n, true, "unexported field is copied as is")
+}
+
+func TestStructuredCloneArray(t *testing.T) {
+	arr := value.NewArray(1, "a").Freeze()
+	got, _ := StructuredClone(arr)
+	TestLog("TestStructuredCloneArray", t, arr, got.Semantics(), value.ArraySemantics, "semantics is kept")
+	TestLog("TestStructuredCloneArray", t, arr, got.IsFrozen(), false, "clone is not frozen")
+	TestLog("TestStructuredCloneArray", t, arr, got.Items, arr.Items, "items")
+
+	obj := map[string]int{"a": 1}
+	v, _ := StructuredClone(value.Object(obj))
+	obj["a"] = 2
+	TestLog("TestStructuredCloneArray", t, obj, v.Interface(), map[string]int{"a": 1}, "object Value is cloned")
+}
+
+func TestStructuredCloneCollections(t *testing.T) {
+	key := &Node{Name: "key"}
+	m := jsmap.New(jsmap.Entry{Key: key, Value: key}, jsmap.Entry{Key: "b", Value: array.MakeArray(1)})
+	got, err := StructuredClone(m)
+	TestLog("TestStructuredCloneCollections", t, m, err, nil, "no error")
+	keys := got.KeysArray()
+	ck := keys.Items[0].Data.(*Node)
+	cv, _ := got.Get(ck)
+	TestLog("TestStructuredCloneCollections", t, m, ck != key && cv == ck, true, "key & value are same clone")
+	TestLog("TestStructuredCloneCollections", t, m, keys.Items[1].Data, "b", "order is kept")
+
+	s := set.New(1, key)
+	cs, _ := StructuredClone(s)
+	TestLog("TestStructuredCloneCollections", t, s, []interface{}{cs.Size(), cs.Has(1), cs.Has(key)}, []interface{}{2, true, false}, "set elements are cloned")
+}
+
+func TestStructuredCloneTypedArray(t *testing.T) {
+	buf, _ := typedarray.NewResizableArrayBuffer(8, 16)
+	whole, _ := typedarray.NewView(typedarray.Uint8, buf, 0, -1)
+	part, _ := typedarray.NewView(typedarray.Uint16, buf, 2, 2)
+	whole.Set(2, 7)
+
+	got, err := StructuredClone([]interface{}{whole, part})
+	TestLog("TestStructuredCloneTypedArray", t, "views", err, nil, "no error")
+	cw, cp := got[0].(*typedarray.TypedArray), got[1].(*typedarray.TypedArray)
+	TestLog("TestStructuredCloneTypedArray", t, "views", cw.Buffer() == cp.Buffer() && cw.Buffer() != buf, true, "buffer is cloned once")
+	TestLog("TestStructuredCloneTypedArray", t, "views", cw.Buffer().MaxByteLength(), 16, "buffer is resizable")
+	TestLog("TestStructuredCloneTypedArray", t, "views", []interface{}{cp.ByteOffset(), cp.Length(), cw.LengthTracking()}, []interface{}{2, 2, true}, "view layout")
+
+	whole.Set(2, 9)
+	TestLog("TestStructuredCloneTypedArray", t, "views", cw.Buffer().Bytes()[2], byte(7), "bytes are copied")
+
+	shared := typedarray.NewSharedArrayBuffer(4)
+	cs, _ := StructuredClone(shared)
+	TestLog("TestStructuredCloneTypedArray", t, "shared", cs == shared, true, "shared buffer is shared")
+}
+
+func TestStructuredCloneError(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        error
+		description string
+	}{
+		{incoming: func() {}, want: &DataCloneError{Message: "func() could not be cloned"}, description: "func"},
+		{incoming: map[string]interface{}{"c": make(chan int)}, want: &DataCloneError{Message: "chan int could not be cloned"}, description: "nested chan"},
+		{incoming: array.MakeArray(1, value.NewSymbol("s")), want: &DataCloneError{Message: "Symbol(s) could not be cloned"}, description: "symbol"},
+	}
+
+	for _, tt := range tests {
+		got, err := StructuredClone(tt.incoming)
+		TestLog("TestStructuredCloneError", t, tt.incoming, err, tt.want, tt.description)
+		TestLog("TestStructuredCloneError", t, tt.incoming, got, nil, tt.description)
+	}
+
+	var fn func()
+	got, err := StructuredClone(fn)
+	TestLog("TestStructuredCloneError", t, "nil func", []interface{}{got == nil, err}, []interface{}{true, nil}, "nil func is kept")
+}
@@ -0,0 +1,10 @@
+package clone
+
+// DataCloneError is error of value which can't be cloned, like js DataCloneError
+type DataCloneError struct {
+	Message string
+}
+
+func (e *DataCloneError) Error() string {
+	return "DataCloneError: " + e.Message
+}
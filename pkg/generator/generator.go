@@ -0,0 +1,237 @@
+package generator
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// ErrRunning returned when generator is resumed from its own body
+var ErrRunning = errors.New("TypeError: Generator is already running")
+
+// Result is one generator step, like js {value, done}
+type Result struct {
+	Value interface{}
+	Done  bool
+}
+
+type command int
+
+const (
+	cmdNext command = iota
+	cmdReturn
+	cmdThrow
+	cmdAbort
+)
+
+type resume struct {
+	cmd   command
+	value interface{}
+	err   error
+}
+
+type step struct {
+	value interface{}
+	done  bool
+	err   error
+	panic interface{}
+}
+
+// throwSignal is panic of nil error thrown into body
+type throwSignal struct{}
+
+type state struct {
+	body    func(yield func(v interface{}) (sent interface{}))
+	started bool
+	done    bool
+	running bool
+	aborted bool
+	// returned is set by Return, value is reported even if body ends normally
+	returned bool
+	value    interface{}
+	in      chan resume
+	out     chan step
+}
+
+// Generator is js generator function backed by goroutine
+// 	body goroutine starts on first Next and stops when generator is done
+// 	or when abandoned generator is garbage collected
+// 	Generator is not safe for concurrent use
+type Generator struct {
+	s *state
+}
+
+// New return new Generator; body produce values with yield
+// 	yield return value passed to next Next call
+// 	body can finish generator with error by panic(err)
+func New(body func(yield func(v interface{}) (sent interface{}))) *Generator {
+	g := &Generator{s: &state{
+		body: body,
+		in:   make(chan resume),
+		out:  make(chan step),
+	}}
+	// the goroutine knows only state, so Generator itself can be collected
+	runtime.SetFinalizer(g, func(g *Generator) { g.s.abort() })
+	return g
+}
+
+// Next resume generator; sent is returned from paused yield
+// 	sent of first Next call is ignored, like in js
+func (g *Generator) Next(sent interface{}) (Result, error) {
+	r, err := g.s.resume(resume{cmd: cmdNext, value: sent})
+	runtime.KeepAlive(g)
+	return r, err
+}
+
+// Return finish generator with value; deferred functions of body are run, like js finally
+// 	body is unwound by runtime.Goexit, so recover() can't stop it
+func (g *Generator) Return(value interface{}) (Result, error) {
+	r, err := g.s.resume(resume{cmd: cmdReturn, value: value})
+	runtime.KeepAlive(g)
+	return r, err
+}
+
+// Throw make paused yield panic with err, like js throw
+// 	body can catch err by recover() in deferred function & continue yielding, like js try/catch
+// 	if body don't recover it, generator is finished and err is returned
+// 	Return & abandoning unwind body by runtime.Goexit, so recover() catches only thrown errors
+func (g *Generator) Throw(err error) (Result, error) {
+	r, e := g.s.resume(resume{cmd: cmdThrow, err: err})
+	runtime.KeepAlive(g)
+	return r, e
+}
+
+// Iterator return iterator over generator values, usable as array.Iterator
+func (g *Generator) Iterator() *Iterator {
+	return &Iterator{g: g}
+}
+
+// ToArray return Array with all rest generator values
+func (g *Generator) ToArray() (*array.Array, error) {
+	it := g.Iterator()
+	arr := array.FromIterator(it)
+	return arr, it.Err()
+}
+
+func (s *state) resume(r resume) (Result, error) {
+	if s.running {
+		return Result{}, ErrRunning
+	}
+	if s.done {
+		switch r.cmd {
+		case cmdReturn:
+			return Result{Value: r.value, Done: true}, nil
+		case cmdThrow:
+			return Result{Done: true}, r.err
+		}
+		return Result{Done: true}, nil
+	}
+
+	if !s.started {
+		switch r.cmd {
+		case cmdReturn:
+			s.done = true
+			return Result{Value: r.value, Done: true}, nil
+		case cmdThrow:
+			s.done = true
+			return Result{Done: true}, r.err
+		}
+		s.started = true
+		s.running = true
+		go s.run()
+	} else {
+		s.running = true
+		s.in <- r
+	}
+
+	st := <-s.out
+	s.running = false
+	if st.done {
+		s.done = true
+	}
+	if st.panic != nil {
+		panic(st.panic)
+	}
+	return Result{Value: st.value, Done: st.done}, st.err
+}
+
+func (s *state) run() {
+	defer func() {
+		st := step{done: true}
+		switch p := recover().(type) {
+		case nil:
+			if s.returned {
+				st.value = s.value
+			}
+		case throwSignal:
+		case error:
+			st.err = p
+		default:
+			st.panic = p
+		}
+		if s.aborted {
+			return
+		}
+		s.out <- st
+	}()
+	s.body(s.yield)
+}
+
+func (s *state) yield(v interface{}) interface{} {
+	if s.aborted {
+		runtime.Goexit()
+	}
+	s.out <- step{value: v}
+	r := <-s.in
+	switch r.cmd {
+	case cmdReturn:
+		s.returned, s.value = true, r.value
+		runtime.Goexit()
+	case cmdThrow:
+		if r.err != nil {
+			panic(r.err)
+		}
+		// panic(nil) isn't recoverable as nil, so nil error is thrown by signal
+		panic(throwSignal{})
+	case cmdAbort:
+		s.aborted = true
+		runtime.Goexit()
+	}
+	return r.value
+}
+
+// abort unwind body of abandoned generator
+func (s *state) abort() {
+	if !s.started || s.done {
+		return
+	}
+	go func() { s.in <- resume{cmd: cmdAbort} }()
+}
+
+// Iterator is generator adapter for array.Iterator
+type Iterator struct {
+	g   *Generator
+	err error
+}
+
+// Next return next generator value
+func (it *Iterator) Next() (interface{}, bool) {
+	if it.err != nil {
+		return nil, true
+	}
+	r, err := it.g.Next(nil)
+	if err != nil {
+		it.err = err
+		return nil, true
+	}
+	if r.Done {
+		return nil, true
+	}
+	return r.Value, false
+}
+
+// Err return error which stopped iteration or nil
+func (it *Iterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,251 @@
+package generator
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func count(n int) *Generator {
+	return New(func(yield func(v interface{}) interface{}) {
+		for i := 0; i < n; i++ {
+			yield(i)
+		}
+	})
+}
+
+func TestGeneratorNext(t *testing.T) {
+	g := count(2)
+	want := []Result{{0, false}, {1, false}, {nil, true}, {nil, true}}
+	for i, w := range want {
+		got, err := g.Next(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		TestLog("Next", t, i, got, w, "count to 2")
+	}
+}
+
+func TestGeneratorSent(t *testing.T) {
+	g := New(func(yield func(v interface{}) interface{}) {
+		sum := 0
+		for {
+			v := yield(sum)
+			if v == nil {
+				return
+			}
+			sum += v.(int)
+		}
+	})
+
+	tests := []struct {
+		sent        interface{}
+		want        Result
+		description string
+	}{
+		{sent: 100, want: Result{0, false}, description: "first sent is ignored"},
+		{sent: 2, want: Result{2, false}, description: "sent returned from yield"},
+		{sent: 3, want: Result{5, false}, description: "sent accumulated"},
+		{sent: nil, want: Result{nil, true}, description: "body returned"},
+	}
+
+	for _, tt := range tests {
+		got, _ := g.Next(tt.sent)
+		TestLog("Next", t, tt.sent, got, tt.want, tt.description)
+	}
+}
+
+func TestGeneratorReturn(t *testing.T) {
+	cleaned := false
+	g := New(func(yield func(v interface{}) interface{}) {
+		defer func() { cleaned = true }()
+		yield(1)
+		yield(2)
+	})
+
+	got, _ := g.Return("early")
+	TestLog("Return", t, "early", got, Result{"early", true}, "return before start")
+
+	g = New(func(yield func(v interface{}) interface{}) {
+		defer func() { cleaned = true }()
+		yield(1)
+		yield(2)
+	})
+	g.Next(nil)
+	got, _ = g.Return("done")
+	TestLog("Return", t, "done", got, Result{"done", true}, "return paused generator")
+	TestLog("Return", t, "done", cleaned, true, "deferred functions are run")
+
+	got, _ = g.Next(nil)
+	TestLog("Return", t, nil, got, Result{nil, true}, "next after return")
+}
+
+func TestGeneratorReturnYieldInDefer(t *testing.T) {
+	g := New(func(yield func(v interface{}) interface{}) {
+		defer yield("finally")
+		yield(1)
+	})
+	g.Next(nil)
+	got, _ := g.Return("done")
+	TestLog("Return", t, "done", got, Result{"finally", false}, "yield in defer, like finally")
+	got, _ = g.Next(nil)
+	TestLog("Return", t, "done", got, Result{"done", true}, "return value after finally")
+}
+
+func TestGeneratorThrow(t *testing.T) {
+	errTest := errors.New("test")
+	g := count(3)
+	g.Next(nil)
+	got, err := g.Throw(errTest)
+	TestLog("Throw", t, errTest, got, Result{nil, true}, "uncaught throw finish generator")
+	TestLog("Throw", t, errTest, err, errTest, "uncaught throw return error")
+
+	g = New(func(yield func(v interface{}) interface{}) {
+		defer func() {
+			if recover() != nil {
+				yield("caught")
+			}
+		}()
+		yield(1)
+	})
+	g.Next(nil)
+	got, err = g.Throw(errTest)
+	TestLog("Throw", t, errTest, got, Result{"caught", false}, "recovered throw")
+	TestLog("Throw", t, errTest, err, nil, "recovered throw has no error")
+
+	g = New(func(yield func(v interface{}) interface{}) {
+		panic(errTest)
+	})
+	_, err = g.Next(nil)
+	TestLog("Throw", t, errTest, err, errTest, "body panic with error")
+}
+
+func TestGeneratorThrowCatch(t *testing.T) {
+	errTest := errors.New("test")
+	// like js: for (let i = 0; i < 3; i++) { try { yield i } catch (e) { yield e.message } }
+	g := New(func(yield func(v interface{}) interface{}) {
+		for i := 0; i < 3; i++ {
+			func() {
+				defer func() {
+					if err, ok := recover().(error); ok {
+						yield("caught " + err.Error())
+					}
+				}()
+				yield(i)
+			}()
+		}
+	})
+
+	got, _ := g.Next(nil)
+	TestLog("Throw", t, errTest, got, Result{0, false}, "first value")
+	got, err := g.Throw(errTest)
+	TestLog("Throw", t, errTest, got, Result{"caught test", false}, "body catch thrown error")
+	TestLog("Throw", t, errTest, err, nil, "caught throw has no error")
+	got, _ = g.Next(nil)
+	TestLog("Throw", t, errTest, got, Result{1, false}, "generator continue after catch")
+	got, _ = g.Next(nil)
+	TestLog("Throw", t, errTest, got, Result{2, false}, "next value")
+	got, _ = g.Next(nil)
+	TestLog("Throw", t, errTest, got, Result{nil, true}, "generator is done")
+}
+
+// catching return infinite generator which catch thrown errors, like js: for (;;) { try { yield i++ } catch {} }
+func catching() *Generator {
+	return New(func(yield func(v interface{}) interface{}) {
+		for i := 0; ; i++ {
+			func() {
+				defer func() { recover() }()
+				yield(i)
+			}()
+		}
+	})
+}
+
+func TestGeneratorReturnCatch(t *testing.T) {
+	g := catching()
+	g.Next(nil)
+	got, err := g.Return("x")
+	TestLog("Return", t, "x", got, Result{"x", true}, "return isn't caught by recover")
+	TestLog("Return", t, "x", err, nil, "return has no error")
+	got, _ = g.Next(nil)
+	TestLog("Return", t, nil, got, Result{nil, true}, "next after return")
+
+	g = New(func(yield func(v interface{}) interface{}) {
+		defer func() { recover() }()
+		yield(1)
+	})
+	g.Next(nil)
+	got, _ = g.Return("y")
+	TestLog("Return", t, "y", got, Result{"y", true}, "return value after body ends")
+}
+
+func TestGeneratorRunning(t *testing.T) {
+	var g *Generator
+	var inner error
+	g = New(func(yield func(v interface{}) interface{}) {
+		_, inner = g.Next(nil)
+	})
+	g.Next(nil)
+	TestLog("Next", t, nil, inner, ErrRunning, "reentrant next")
+}
+
+func TestGeneratorToArray(t *testing.T) {
+	got, err := count(3).ToArray()
+	TestLog("ToArray", t, 3, got, array.MakeArray(0, 1, 2), "to array")
+	TestLog("ToArray", t, 3, err, nil, "to array error")
+
+	got = array.FromIterator(count(2).Iterator())
+	TestLog("FromIterator", t, 2, got, array.MakeArray(0, 1), "iterator source")
+
+	errTest := errors.New("test")
+	g := New(func(yield func(v interface{}) interface{}) {
+		yield(1)
+		panic(errTest)
+	})
+	got, err = g.ToArray()
+	TestLog("ToArray", t, errTest, got, array.MakeArray(1), "partial array")
+	TestLog("ToArray", t, errTest, err, errTest, "iteration error")
+}
+
+func TestGeneratorAbandoned(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		g := count(10)
+		g.Next(nil)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	TestLog("Abandoned", t, 100, runtime.NumGoroutine() <= before, true, "goroutines are released")
+}
+
+func TestGeneratorAbandonedCatch(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		g := catching()
+		g.Next(nil)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	TestLog("Abandoned", t, 100, runtime.NumGoroutine() <= before, true, "goroutines of catching body are released")
+}
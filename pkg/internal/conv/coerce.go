@@ -0,0 +1,115 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// Number return float64 of go number type; ok is false for other types
+func Number(v interface{}) (f float64, ok bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case uintptr:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// Integer return *big.Int of go integer type; ok is false for other types
+func Integer(v interface{}) (n *big.Int, ok bool) {
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), true
+	}
+	return nil, false
+}
+
+// ToNumber return js number of primitive go value, like js Number(v)
+// 	nil is 0, bool is 0 or 1, string is parsed by StringToNumber, *big.Int is rounded
+// 	ok is false for other types
+func ToNumber(v interface{}) (f float64, ok bool) {
+	if f, ok := Number(v); ok {
+		return f, true
+	}
+	switch v := v.(type) {
+	case nil:
+		return 0, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case string:
+		return StringToNumber(v), true
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f, true
+	}
+	return 0, false
+}
+
+// ToBoolean return js boolean of go value, like js Boolean(v)
+// 	false are nil, nil pointers, maps, slices & funcs, false, 0, NaN, 0n & empty string
+func ToBoolean(v interface{}) bool {
+	if f, ok := Number(v); ok {
+		return f != 0 && !math.IsNaN(f)
+	}
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case *big.Int:
+		return v != nil && v.Sign() != 0
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return !rv.IsNil()
+	}
+	return true
+}
+
+// ToInt32 return f wrapped to int32, like js ToInt32; NaN & Infinity are 0
+func ToInt32(f float64) int32 {
+	return int32(ToUint32(f))
+}
+
+// ToUint32 return f wrapped to uint32, like js ToUint32; NaN & Infinity are 0
+func ToUint32(f float64) uint32 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	m := math.Mod(math.Trunc(f), 1<<32)
+	if m < 0 {
+		m += 1 << 32
+	}
+	return uint32(m)
+}
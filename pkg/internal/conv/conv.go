@@ -0,0 +1,107 @@
+package conv
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NumberToString return js Number::toString of f
+func NumberToString(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case f == 0:
+		return "0"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f < 0:
+		return "-" + NumberToString(-f)
+	}
+
+	// shortest round trip digits & exponent: f = 0.digits * 10^n
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mant, exp := e, 0
+	if i := strings.IndexByte(e, 'e'); i >= 0 {
+		mant = e[:i]
+		exp, _ = strconv.Atoi(e[i+1:])
+	}
+	digits := strings.Replace(mant, ".", "", 1)
+	k, n := len(digits), exp+1
+
+	switch {
+	case k <= n && n <= 21:
+		return digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return "0." + strings.Repeat("0", -n) + digits
+	}
+
+	sign := "+"
+	if n-1 < 0 {
+		sign = "-"
+	}
+	exps := strconv.Itoa(abs(n - 1))
+	if k == 1 {
+		return digits + "e" + sign + exps
+	}
+	return digits[:1] + "." + digits[1:] + "e" + sign + exps
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ToString return js string of primitive go value
+// 	nil is null; numbers are formatted like js numbers
+func ToString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case uintptr:
+		return strconv.FormatUint(uint64(v), 10)
+	case float32:
+		return NumberToString(float64(v))
+	case float64:
+		return NumberToString(v)
+	case *big.Int:
+		return v.String()
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,146 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestNumberToString(t *testing.T) {
+	tests := []struct {
+		incoming float64
+		want     string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "0"},
+		{1, "1"},
+		{-1.5, "-1.5"},
+		{0.1, "0.1"},
+		{0.30000000000000004, "0.30000000000000004"},
+		{123456789, "123456789"},
+		{1e21, "1e+21"},
+		{1e20, "100000000000000000000"},
+		{123e-20, "1.23e-18"},
+		{0.000001, "0.000001"},
+		{0.0000001, "1e-7"},
+		{1.5e300, "1.5e+300"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+		{5e-324, "5e-324"},
+		{math.NaN(), "NaN"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+	}
+
+	for _, tt := range tests {
+		if got := NumberToString(tt.incoming); got != tt.want {
+			t.Errorf("NumberToString(%v) = %v, want %v", tt.incoming, got, tt.want)
+		}
+	}
+}
+
+func TestToString(t *testing.T) {
+	tests := []struct {
+		incoming interface{}
+		want     string
+	}{
+		{nil, "null"},
+		{"str", "str"},
+		{true, "true"},
+		{-12, "-12"},
+		{uint8(200), "200"},
+		{float32(1.5), "1.5"},
+		{2.0, "2"},
+		{big.NewInt(12), "12"},
+	}
+
+	for _, tt := range tests {
+		if got := ToString(tt.incoming); got != tt.want {
+			t.Errorf("ToString(%v) = %v, want %v", tt.incoming, got, tt.want)
+		}
+	}
+}
+
+func TestStringToNumber(t *testing.T) {
+	tests := []struct {
+		incoming string
+		want     float64
+	}{
+		{"", 0},
+		{"  12  ", 12},
+		{" \n-1.5e3\t", -1500},
+		{".5", 0.5},
+		{"5.", 5},
+		{"0x1f", 31},
+		{"0B101", 5},
+		{"0o17", 15},
+		{"+Infinity", math.Inf(1)},
+		{"-Infinity", math.Inf(-1)},
+		{"1e400", math.Inf(1)},
+		{"0x", math.NaN()},
+		{"-0x1f", math.NaN()},
+		{"1_000", math.NaN()},
+		{"inf", math.NaN()},
+		{"NaN", math.NaN()},
+		{".", math.NaN()},
+		{"1e", math.NaN()},
+		{"12px", math.NaN()},
+	}
+
+	for _, tt := range tests {
+		got := StringToNumber(tt.incoming)
+		if got != tt.want && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+			t.Errorf("StringToNumber(%q) = %v, want %v", tt.incoming, got, tt.want)
+		}
+	}
+}
+
+func TestStringToBigInt(t *testing.T) {
+	tests := []struct {
+		incoming string
+		want     string
+		ok       bool
+	}{
+		{"", "0", true},
+		{" -12 ", "-12", true},
+		{"+7", "7", true},
+		{"0xff", "255", true},
+		{"9007199254740993", "9007199254740993", true},
+		{"1.5", "", false},
+		{"1e3", "", false},
+		{"-0x1", "", false},
+		{"1_0", "", false},
+		{"0b", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := StringToBigInt(tt.incoming)
+		if ok != tt.ok || ok && got.String() != tt.want {
+			t.Errorf("StringToBigInt(%q) = %v, %v, want %v, %v", tt.incoming, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestToUint32(t *testing.T) {
+	tests := []struct {
+		incoming float64
+		int32    int32
+		uint32   uint32
+	}{
+		{0, 0, 0},
+		{-1, -1, 4294967295},
+		{4294967296, 0, 0},
+		{2147483648, -2147483648, 2147483648},
+		{-4294967297.9, -1, 4294967295},
+		{math.NaN(), 0, 0},
+		{math.Inf(-1), 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := ToInt32(tt.incoming); got != tt.int32 {
+			t.Errorf("ToInt32(%v) = %v, want %v", tt.incoming, got, tt.int32)
+		}
+		if got := ToUint32(tt.incoming); got != tt.uint32 {
+			t.Errorf("ToUint32(%v) = %v, want %v", tt.incoming, got, tt.uint32)
+		}
+	}
+}
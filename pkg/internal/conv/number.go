@@ -0,0 +1,150 @@
+package conv
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// IsSpace check is c js WhiteSpace or LineTerminator
+func IsSpace(c rune) bool {
+	switch c {
+	case '\t', '\n', '\v', '\f', '\r', ' ', '\u00a0', '\u1680', '\u2028', '\u2029', '\u202f', '\u205f', '\u3000', '\ufeff':
+		return true
+	}
+	return c >= '\u2000' && c <= '\u200a'
+}
+
+// TrimSpace return s without leading & trailing js whitespace, like js trim
+func TrimSpace(s string) string {
+	return strings.TrimFunc(s, IsSpace)
+}
+
+// StringToNumber return js number of s, like js Number(s)
+// 	s is trimmed; empty string is 0; 0x, 0o & 0b prefixes are supported; invalid string is NaN
+func StringToNumber(s string) float64 {
+	s = TrimSpace(s)
+	switch s {
+	case "":
+		return 0
+	case "Infinity", "+Infinity":
+		return math.Inf(1)
+	case "-Infinity":
+		return math.Inf(-1)
+	}
+
+	if base := prefixBase(s); base != 0 {
+		n, ok := new(big.Int).SetString(s[2:], base)
+		if !ok || !digitsOnly(s[2:], base) {
+			return math.NaN()
+		}
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return f
+	}
+
+	if !isDecimal(s) {
+		return math.NaN()
+	}
+	// out of range error still return correct ±Inf or 0
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
+// StringToBigInt return js bigint of s, like js BigInt(s)
+// 	s is trimmed; empty string is 0; ok is false for invalid string
+func StringToBigInt(s string) (n *big.Int, ok bool) {
+	s = TrimSpace(s)
+	if s == "" {
+		return new(big.Int), true
+	}
+
+	base, digits := 10, s
+	if b := prefixBase(s); b != 0 {
+		base, digits = b, s[2:]
+	} else if s[0] == '+' || s[0] == '-' {
+		digits = s[1:]
+	}
+	if !digitsOnly(digits, base) {
+		return nil, false
+	}
+	if base != 10 {
+		s = digits
+	}
+	return new(big.Int).SetString(s, base)
+}
+
+// prefixBase return base of 0x, 0o & 0b prefix or 0
+func prefixBase(s string) int {
+	if len(s) < 2 || s[0] != '0' {
+		return 0
+	}
+	switch s[1] {
+	case 'x', 'X':
+		return 16
+	case 'o', 'O':
+		return 8
+	case 'b', 'B':
+		return 2
+	}
+	return 0
+}
+
+// digitsOnly check is s non empty string of digits of base; big.Int.SetString also allows "_"
+func digitsOnly(s string, base int) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case c >= 'a' && c <= 'f':
+			d = int(c-'a') + 10
+		case c >= 'A' && c <= 'F':
+			d = int(c-'A') + 10
+		default:
+			return false
+		}
+		if d >= base {
+			return false
+		}
+	}
+	return true
+}
+
+// isDecimal check is s js StrDecimalLiteral without Infinity
+// 	strconv.ParseFloat also allows "inf", "nan", hex floats & "_", so syntax is checked here
+func isDecimal(s string) bool {
+	i := 0
+	if s[0] == '+' || s[0] == '-' {
+		i++
+	}
+	digits := func() int {
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		return i - start
+	}
+
+	n := digits()
+	if i < len(s) && s[i] == '.' {
+		i++
+		n += digits()
+	}
+	if n == 0 {
+		return false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		if digits() == 0 {
+			return false
+		}
+	}
+	return i == len(s)
+}
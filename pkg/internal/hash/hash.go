@@ -0,0 +1,73 @@
+package hash
+
+import (
+	"math"
+	"reflect"
+)
+
+type nanKey struct{}
+
+// refKey is key of value compared by identity
+type refKey struct {
+	t   reflect.Type
+	ptr uintptr
+	len int
+}
+
+// Key return go map key for v consistent with js SameValueZero
+// 	NaN values have same key, +0 & -0 have same key
+// 	slices, maps & funcs have key of their identity
+// 	ok is false for values without map key: structs with uncomparable fields
+func Key(v interface{}) (key interface{}, ok bool) {
+	if v == nil {
+		return nil, true
+	}
+	if IsNaN(v) {
+		return nanKey{}, true
+	}
+	v = Zero(v)
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		return refKey{t: rv.Type(), ptr: rv.Pointer(), len: rv.Len()}, true
+	case reflect.Map, reflect.Func:
+		return refKey{t: rv.Type(), ptr: rv.Pointer()}, true
+	}
+	if !rv.Type().Comparable() {
+		return nil, false
+	}
+	defer func() {
+		if recover() != nil {
+			key, ok = nil, false
+		}
+	}()
+	_ = map[interface{}]struct{}{v: {}}
+	return v, true
+}
+
+// Zero return +0 for -0 float, otherwise v
+func Zero(v interface{}) interface{} {
+	switch f := v.(type) {
+	case float64:
+		if f == 0 {
+			return float64(0)
+		}
+	case float32:
+		if f == 0 {
+			return float32(0)
+		}
+	}
+	return v
+}
+
+// IsNaN check is v float NaN
+func IsNaN(v interface{}) bool {
+	switch f := v.(type) {
+	case float64:
+		return math.IsNaN(f)
+	case float32:
+		return math.IsNaN(float64(f))
+	}
+	return false
+}
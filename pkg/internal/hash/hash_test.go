@@ -0,0 +1,55 @@
+package hash
+
+import (
+	"math"
+	"testing"
+)
+
+type uncomparable struct {
+	s []int
+}
+
+func TestKey(t *testing.T) {
+	s := []int{1, 2}
+	m := map[string]int{}
+	tests := []struct {
+		a, b  interface{}
+		equal bool
+	}{
+		{math.NaN(), math.NaN(), true},
+		{0.0, math.Copysign(0, -1), true},
+		{float32(0), float32(math.Copysign(0, -1)), true},
+		{1, 1.0, false},
+		{"a", "a", true},
+		{nil, nil, true},
+		{s, s, true},
+		{s, []int{1, 2}, false},
+		{s, s[:1], false},
+		{m, m, true},
+		{m, map[string]int{}, false},
+	}
+
+	for _, tt := range tests {
+		ka, _ := Key(tt.a)
+		kb, _ := Key(tt.b)
+		if got := ka == kb; got != tt.equal {
+			t.Errorf("Key(%v) == Key(%v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+	}
+
+	if _, ok := Key(uncomparable{}); ok {
+		t.Errorf("Key(uncomparable{}) ok = true, want false")
+	}
+	if _, ok := Key(interface{}(struct{ v interface{} }{[]int{}})); ok {
+		t.Errorf("Key(struct with slice in interface) ok = true, want false")
+	}
+}
+
+func TestZero(t *testing.T) {
+	if got := Zero(math.Copysign(0, -1)).(float64); math.Signbit(got) {
+		t.Errorf("Zero(-0) = -0, want +0")
+	}
+	if got := Zero("a"); got != "a" {
+		t.Errorf("Zero(a) = %v, want a", got)
+	}
+}
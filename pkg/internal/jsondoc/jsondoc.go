@@ -0,0 +1,115 @@
+package jsondoc
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+// helpers of JSON documents shared by json & jsonpath packages
+// 	object is *jsmap.Map with string keys or map[string]interface{}
+
+// IsObject check is v object of document
+func IsObject(v interface{}) bool {
+	switch v.(type) {
+	case *jsmap.Map, map[string]interface{}:
+		return true
+	}
+	return false
+}
+
+// Member return member of object by key
+func Member(o interface{}, key string) (interface{}, bool) {
+	switch o := o.(type) {
+	case *jsmap.Map:
+		return o.Get(key)
+	case map[string]interface{}:
+		v, ok := o[key]
+		return v, ok
+	}
+	return nil, false
+}
+
+// SetMember set member of object by key
+func SetMember(o interface{}, key string, v interface{}) {
+	switch o := o.(type) {
+	case *jsmap.Map:
+		o.Set(key, v)
+	case map[string]interface{}:
+		o[key] = v
+	}
+}
+
+// DeleteMember remove member of object by key; return false if there is no member
+func DeleteMember(o interface{}, key string) bool {
+	switch o := o.(type) {
+	case *jsmap.Map:
+		return o.Delete(key)
+	case map[string]interface{}:
+		_, ok := o[key]
+		delete(o, key)
+		return ok
+	}
+	return false
+}
+
+// MemberKeys return keys of object; jsmap keys are in insertion order, map keys are sorted
+func MemberKeys(o interface{}) []string {
+	var keys []string
+	switch o := o.(type) {
+	case *jsmap.Map:
+		o.ForEach(func(_, k interface{}, _ *jsmap.Map) {
+			if s, ok := k.(string); ok {
+				keys = append(keys, s)
+			}
+		})
+	case map[string]interface{}:
+		for k := range o {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+	return keys
+}
+
+// Equal check are documents equal
+// 	numbers are equal by value, objects are equal by members regardless of order
+func Equal(a, b interface{}) bool {
+	if fa, ok := conv.Number(a); ok {
+		fb, ok := conv.Number(b)
+		return ok && fa == fb
+	}
+	if arr, ok := a.(*array.Array); ok {
+		other, ok := b.(*array.Array)
+		if !ok || len(arr.Items) != len(other.Items) {
+			return false
+		}
+		for i := range arr.Items {
+			if !Equal(arr.Items[i].Data, other.Items[i].Data) {
+				return false
+			}
+		}
+		return true
+	}
+	if IsObject(a) {
+		if !IsObject(b) {
+			return false
+		}
+		keys := MemberKeys(a)
+		if len(keys) != len(MemberKeys(b)) {
+			return false
+		}
+		for _, k := range keys {
+			va, _ := Member(a, k)
+			vb, ok := Member(b, k)
+			if !ok || !Equal(va, vb) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,62 @@
+package jsondoc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+func TestEqual(t *testing.T) {
+	ordered := jsmap.New()
+	ordered.Set("b", 2)
+	ordered.Set("a", array.MakeArray(1.0, "x"))
+	tests := []struct {
+		a, b  interface{}
+		equal bool
+	}{
+		{1, 1.0, true},
+		{1, "1", false},
+		{"a", "a", true},
+		{nil, nil, true},
+		{true, false, false},
+		{array.MakeArray(1, 2), array.MakeArray(1.0, 2.0), true},
+		{array.MakeArray(1, 2), array.MakeArray(2, 1), false},
+		{array.MakeArray(1), array.MakeArray(1, 1), false},
+		{ordered, map[string]interface{}{"a": array.MakeArray(1, "x"), "b": 2.0}, true},
+		{ordered, map[string]interface{}{"a": array.MakeArray(1, "x")}, false},
+		{map[string]interface{}{}, array.NewArray(), false},
+	}
+
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.equal {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+	}
+}
+
+func TestMembers(t *testing.T) {
+	m := jsmap.New()
+	m.Set("z", 1)
+	m.Set("a", 2)
+	o := map[string]interface{}{"z": 1, "a": 2}
+
+	if got := MemberKeys(m); !reflect.DeepEqual(got, []string{"z", "a"}) {
+		t.Errorf("MemberKeys(jsmap) = %v, want insertion order", got)
+	}
+	if got := MemberKeys(o); !reflect.DeepEqual(got, []string{"a", "z"}) {
+		t.Errorf("MemberKeys(map) = %v, want sorted", got)
+	}
+
+	SetMember(o, "b", 3)
+	if v, ok := Member(o, "b"); !ok || v != 3 {
+		t.Errorf("Member(map, b) = %v, %v, want 3, true", v, ok)
+	}
+	if !DeleteMember(m, "z") || DeleteMember(m, "z") {
+		t.Errorf("DeleteMember(jsmap, z) must delete once")
+	}
+	if _, ok := Member(array.NewArray(), "0"); ok || IsObject(array.NewArray()) {
+		t.Errorf("array isn't object")
+	}
+}
@@ -0,0 +1,91 @@
+package jsmap
+
+import "github.com/miron-developer/golang-js-utils/pkg/array"
+
+type iterKind int
+
+const (
+	iterKeys iterKind = iota
+	iterValues
+	iterEntries
+)
+
+// Iterator is live iterator of Map, usable as array.Iterator
+// 	entries added during iteration are visited, deleted are skipped, like in js
+type Iterator struct {
+	kind iterKind
+	cur  *entry
+	done bool
+}
+
+func (m *Map) iterator(kind iterKind) *Iterator {
+	m.init()
+	return &Iterator{kind: kind, cur: m.head}
+}
+
+// nextEntry return next live entry or nil when iterator is exhausted
+func (it *Iterator) nextEntry() *entry {
+	if it.done {
+		return nil
+	}
+	e := it.cur
+	// entries before deleted one can be deleted too, but head is live
+	for e.deleted {
+		e = e.prev
+	}
+	if e.next == nil {
+		it.done = true
+		return nil
+	}
+	it.cur = e.next
+	return it.cur
+}
+
+// Next return next key, value or Entry
+func (it *Iterator) Next() (interface{}, bool) {
+	e := it.nextEntry()
+	if e == nil {
+		return nil, true
+	}
+	switch it.kind {
+	case iterKeys:
+		return e.Key, false
+	case iterValues:
+		return e.Value, false
+	}
+	return e.Entry, false
+}
+
+// Keys return iterator of keys, like js keys
+func (m *Map) Keys() *Iterator {
+	return m.iterator(iterKeys)
+}
+
+// Values return iterator of values, like js values
+func (m *Map) Values() *Iterator {
+	return m.iterator(iterValues)
+}
+
+// Entries return iterator of Entry, like js entries
+func (m *Map) Entries() *Iterator {
+	return m.iterator(iterEntries)
+}
+
+// KeysArray return Array of keys
+func (m *Map) KeysArray() *array.Array {
+	return array.FromIterator(m.Keys())
+}
+
+// ValuesArray return Array of values
+func (m *Map) ValuesArray() *array.Array {
+	return array.FromIterator(m.Values())
+}
+
+// EntriesArray return Array of [key, value] Arrays, like js Array.from(map)
+func (m *Map) EntriesArray() *array.Array {
+	r := array.NewArray()
+	for e := m.iterator(iterEntries).nextEntry(); e != nil; e = e.next {
+		r.Push(array.MakeArray(e.Key, e.Value))
+	}
+	return r
+}
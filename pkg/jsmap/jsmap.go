@@ -0,0 +1,165 @@
+package jsmap
+
+import (
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/hash"
+)
+
+// Entry is key & value pair of Map
+type Entry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// entry is node of insertion ordered list
+// 	deleted entry keeps prev & next, so iterator standing on it can continue
+type entry struct {
+	Entry
+	prev, next *entry
+	deleted    bool
+}
+
+// Map is js Map: keys are compared by SameValueZero & iterated in insertion order
+// 	keys can be any values: NaN, slices, maps & funcs (by identity)
+// 	structs with uncomparable fields are never equal, so each such key is new entry
+// 	zero Map is empty map ready to use; Map is not safe for concurrent use
+type Map struct {
+	head  *entry
+	tail  *entry
+	index map[interface{}]*entry
+	size  int
+}
+
+// New return new Map with entries, like js new Map(entries)
+func New(entries ...Entry) *Map {
+	m := &Map{}
+	m.Clear()
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return m
+}
+
+// FromArray return new Map of Array with [key, value] Arrays or Entry items
+// 	return TypeError for other items
+func FromArray(arr *array.Array) (*Map, error) {
+	m := New()
+	for _, v := range arr.Items {
+		switch e := v.Data.(type) {
+		case Entry:
+			m.Set(e.Key, e.Value)
+		case *array.Array:
+			var key, value interface{}
+			if len(e.Items) > 0 {
+				key = e.Items[0].Data
+			}
+			if len(e.Items) > 1 {
+				value = e.Items[1].Data
+			}
+			m.Set(key, value)
+		default:
+			return nil, &array.TypeError{Message: "Iterator value " + conv.ToString(v.Data) + " is not an entry object"}
+		}
+	}
+	return m, nil
+}
+
+// find return entry of key
+// 	keys without go map key, like structs with uncomparable fields, are never equal, see array.SameValueZero
+func (m *Map) find(key interface{}) *entry {
+	if hk, ok := hash.Key(key); ok {
+		return m.index[hk]
+	}
+	return nil
+}
+
+// Get return value of key; ok is false if there is no key
+func (m *Map) Get(key interface{}) (value interface{}, ok bool) {
+	if e := m.find(key); e != nil {
+		return e.Value, true
+	}
+	return nil, false
+}
+
+// Has check is there key in m
+func (m *Map) Has(key interface{}) bool {
+	return m.find(key) != nil
+}
+
+// Set set value of key & return m
+// 	new key is added to end, existing key keeps its position; -0 key is stored as +0
+func (m *Map) Set(key, value interface{}) *Map {
+	if e := m.find(key); e != nil {
+		e.Value = value
+		return m
+	}
+	m.init()
+	key = hash.Zero(key)
+	e := &entry{Entry: Entry{Key: key, Value: value}, prev: m.tail}
+	m.tail.next = e
+	m.tail = e
+	m.size++
+	if hk, ok := hash.Key(key); ok {
+		m.index[hk] = e
+	}
+	return m
+}
+
+// Delete remove key; return false if there was no key
+func (m *Map) Delete(key interface{}) bool {
+	e := m.find(key)
+	if e == nil {
+		return false
+	}
+	hk, _ := hash.Key(key)
+	delete(m.index, hk)
+
+	e.deleted = true
+	e.prev.next = e.next
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	m.size--
+	return true
+}
+
+func (m *Map) init() {
+	if m.head == nil {
+		m.Clear()
+	}
+}
+
+// Clear remove all entries
+func (m *Map) Clear() {
+	if m.head != nil {
+		for e := m.head.next; e != nil; e = e.next {
+			e.deleted = true
+		}
+	}
+	// new head: iterators of old entries walk back to old head, so it must see new entries
+	head := &entry{}
+	if m.head != nil {
+		m.head.deleted = true
+		m.head.prev = head
+	}
+	m.head, m.tail = head, head
+	m.index = map[interface{}]*entry{}
+	m.size = 0
+}
+
+// Size return count of entries
+func (m *Map) Size() int {
+	return m.size
+}
+
+// ForEach call callback for each entry in insertion order, like js forEach
+// 	entries added during iteration are visited, deleted are skipped
+func (m *Map) ForEach(callback func(value, key interface{}, m *Map)) {
+	it := m.iterator(iterEntries)
+	for e := it.nextEntry(); e != nil; e = it.nextEntry() {
+		callback(e.Value, e.Key, m)
+	}
+}
@@ -0,0 +1,119 @@
+package jsmap
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+type point struct {
+	tags []string
+}
+
+func TestMapKeys(t *testing.T) {
+	s := []int{1}
+	p := point{}
+	tests := []struct {
+		set, get    interface{}
+		found       bool
+		description string
+	}{
+		{set: math.NaN(), get: math.NaN(), found: true, description: "NaN key"},
+		{set: math.Copysign(0, -1), get: 0.0, found: true, description: "-0 & +0 key"},
+		{set: 1, get: 1.0, found: false, description: "different go types"},
+		{set: s, get: s, found: true, description: "slice by identity"},
+		{set: s, get: []int{1}, found: false, description: "other slice"},
+		{set: p, get: p, found: false, description: "uncomparable struct is never equal"},
+		{set: nil, get: nil, found: true, description: "nil key"},
+	}
+
+	for _, tt := range tests {
+		m := New().Set(tt.set, "v")
+		_, found := m.Get(tt.get)
+		TestLog("Get", t, tt.get, found, tt.found, tt.description)
+		TestLog("Has", t, tt.get, m.Has(tt.get), tt.found, tt.description)
+		TestLog("Delete", t, tt.get, m.Delete(tt.get), tt.found, tt.description)
+	}
+
+	m := New().Set(math.Copysign(0, -1), 1)
+	TestLog("Set", t, "-0", math.Signbit(m.KeysArray().Items[0].Data.(float64)), false, "-0 key stored as +0")
+}
+
+func TestMapOrder(t *testing.T) {
+	m := New(Entry{"a", 1}, Entry{"b", 2}, Entry{"c", 3})
+	m.Set("a", 10)
+	m.Delete("b")
+	m.Set("b", 20)
+	TestLog("Keys", t, "a,c,b", m.KeysArray(), array.MakeArray("a", "c", "b"), "existing key keeps position")
+	TestLog("Values", t, "a,c,b", m.ValuesArray(), array.MakeArray(10, 3, 20), "values")
+	TestLog("Entries", t, "a,c,b", m.EntriesArray().Items[0].Data, array.MakeArray("a", 10), "entries as pairs")
+	TestLog("Size", t, "a,c,b", m.Size(), 3, "size")
+
+	e, _ := m.Entries().Next()
+	TestLog("Entries", t, "a,c,b", e, Entry{"a", 10}, "entries iterator")
+}
+
+func TestMapLiveIteration(t *testing.T) {
+	m := New(Entry{1, nil}, Entry{2, nil}, Entry{3, nil}, Entry{4, nil})
+	var got []interface{}
+	m.ForEach(func(value, key interface{}, m *Map) {
+		got = append(got, key)
+		switch key {
+		case 1:
+			m.Delete(2)
+			m.Set(5, nil)
+		case 3:
+			m.Delete(3)
+			m.Delete(4)
+			m.Delete(5)
+			m.Set(6, nil)
+		}
+	})
+	TestLog("ForEach", t, "1..4", got, []interface{}{1, 3, 6}, "deleted skipped, added visited")
+
+	m = New(Entry{1, nil}, Entry{2, nil})
+	it := m.Keys()
+	it.Next()
+	m.Clear()
+	m.Set(3, nil)
+	got = nil
+	for k, done := it.Next(); !done; k, done = it.Next() {
+		got = append(got, k)
+	}
+	TestLog("Keys", t, "clear", got, []interface{}{3}, "iteration continue after clear")
+
+	m.Set(4, nil)
+	_, done := it.Next()
+	TestLog("Keys", t, "done", done, true, "exhausted iterator stays done")
+}
+
+func TestMapZero(t *testing.T) {
+	var m Map
+	TestLog("Size", t, "zero", m.Size(), 0, "zero map size")
+	_, done := m.Keys().Next()
+	TestLog("Keys", t, "zero", done, true, "zero map iteration")
+	m.Set("a", 1)
+	v, _ := m.Get("a")
+	TestLog("Set", t, "zero", v, 1, "zero map is usable")
+}
+
+func TestMapFromArray(t *testing.T) {
+	m, err := FromArray(array.MakeArray(array.MakeArray("a", 1), Entry{"b", 2}, array.MakeArray("c")))
+	TestLog("FromArray", t, "pairs", m.EntriesArray(), array.MakeArray(array.MakeArray("a", 1), array.MakeArray("b", 2), array.MakeArray("c", nil)), "entries")
+	TestLog("FromArray", t, "pairs", err, nil, "no error")
+
+	_, err = FromArray(array.MakeArray(1))
+	_, ok := err.(*array.TypeError)
+	TestLog("FromArray", t, 1, ok, true, "not entry")
+}
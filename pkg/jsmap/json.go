@@ -0,0 +1,27 @@
+package jsmap
+
+import "encoding/json"
+
+// MarshalJSON encode m as array of [key, value] pairs in insertion order
+func (m *Map) MarshalJSON() ([]byte, error) {
+	m.init()
+	pairs := make([][2]interface{}, 0, m.size)
+	for e := m.head.next; e != nil; e = e.next {
+		pairs = append(pairs, [2]interface{}{e.Key, e.Value})
+	}
+	return json.Marshal(pairs)
+}
+
+// UnmarshalJSON decode array of [key, value] pairs, like js new Map(JSON.parse(data))
+// 	all previous entries are removed
+func (m *Map) UnmarshalJSON(data []byte) error {
+	var pairs [][2]interface{}
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	m.Clear()
+	for _, p := range pairs {
+		m.Set(p[0], p[1])
+	}
+	return nil
+}
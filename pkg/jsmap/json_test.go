@@ -0,0 +1,23 @@
+package jsmap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestMapJSON(t *testing.T) {
+	m := New(Entry{"b", 1}, Entry{2, []int{1}}, Entry{nil, "x"})
+	data, err := json.Marshal(m)
+	TestLog("MarshalJSON", t, m, string(data), `[["b",1],[2,[1]],[null,"x"]]`, "pairs in insertion order")
+	TestLog("MarshalJSON", t, m, err, nil, "no error")
+
+	got := New(Entry{"old", 0})
+	err = json.Unmarshal(data, got)
+	TestLog("UnmarshalJSON", t, string(data), got.KeysArray(), array.MakeArray("b", 2.0, nil), "keys")
+	TestLog("UnmarshalJSON", t, string(data), err, nil, "no error")
+
+	err = json.Unmarshal([]byte(`{"a":1}`), got)
+	TestLog("UnmarshalJSON", t, `{"a":1}`, err != nil, true, "not array of pairs")
+}
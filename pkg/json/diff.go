@@ -0,0 +1,174 @@
+package json
+
+import (
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+// maxDiffCells is limit of edits table size of arrays, longer arrays are changed element by element
+const maxDiffCells = 1 << 20
+
+// Diff return JSON Patch which change document a into b, like RFC 6902
+// 	objects are compared by members & arrays by shortest edits of elements;
+// 	objects & arrays changed in place are diffed recursively, other changed values are replaced
+// 	return Array of operation objects *jsmap.Map, so Patch(a, Diff(a, b)) is equal to b
+func Diff(a, b interface{}) *array.Array {
+	r := array.NewArray()
+	diff(r, nil, a, b)
+	return r
+}
+
+func operation(op string, path Pointer, v ...interface{}) *jsmap.Map {
+	m := jsmap.New()
+	m.Set("op", op)
+	m.Set("path", path.String())
+	if len(v) > 0 {
+		m.Set("value", v[0])
+	}
+	return m
+}
+
+func diff(r *array.Array, path Pointer, a, b interface{}) {
+	if jsondoc.Equal(a, b) {
+		return
+	}
+	if jsondoc.IsObject(a) && jsondoc.IsObject(b) {
+		diffObjects(r, path, a, b)
+		return
+	}
+	x, okX := a.(*array.Array)
+	y, okY := b.(*array.Array)
+	if okX && okY {
+		diffArrays(r, path, x, y)
+		return
+	}
+	r.Push(operation("replace", path, b))
+}
+
+func diffObjects(r *array.Array, path Pointer, a, b interface{}) {
+	for _, k := range jsondoc.MemberKeys(a) {
+		if _, ok := jsondoc.Member(b, k); !ok {
+			r.Push(operation("remove", path.append(k)))
+		}
+	}
+	for _, k := range jsondoc.MemberKeys(a) {
+		va, _ := jsondoc.Member(a, k)
+		if vb, ok := jsondoc.Member(b, k); ok {
+			diff(r, path.append(k), va, vb)
+		}
+	}
+	for _, k := range jsondoc.MemberKeys(b) {
+		if _, ok := jsondoc.Member(a, k); !ok {
+			vb, _ := jsondoc.Member(b, k)
+			r.Push(operation("add", path.append(k), vb))
+		}
+	}
+}
+
+// edit kinds of array diff
+const (
+	editKeep = iota
+	editChange
+	editRemove
+	editAdd
+)
+
+type edit struct {
+	kind int
+	// i is index of element in a for keep, change & remove; j is index in b for keep, change & add
+	i, j int
+}
+
+func diffArrays(r *array.Array, path Pointer, a, b *array.Array) {
+	x, y := a.Items, b.Items
+	start := 0
+	for start < len(x) && start < len(y) && jsondoc.Equal(x[start].Data, y[start].Data) {
+		start++
+	}
+	endX, endY := len(x), len(y)
+	for endX > start && endY > start && jsondoc.Equal(x[endX-1].Data, y[endY-1].Data) {
+		endX--
+		endY--
+	}
+
+	// i is index of element in patched array
+	i := start
+	for _, e := range arrayEdits(x[start:endX], y[start:endY], start) {
+		switch e.kind {
+		case editKeep:
+			i++
+		case editChange:
+			diff(r, path.append(strconv.Itoa(i)), x[e.i].Data, y[e.j].Data)
+			i++
+		case editRemove:
+			r.Push(operation("remove", path.append(strconv.Itoa(i))))
+		case editAdd:
+			r.Push(operation("add", path.append(strconv.Itoa(i)), y[e.j].Data))
+			i++
+		}
+	}
+}
+
+// arrayEdits return shortest edits of x into y, like Levenshtein distance; indexes start at offset
+// 	change of element is counted as one edit, so changed elements are paired across kept ones
+func arrayEdits(x, y []array.ArrayItem, offset int) []edit {
+	n, m := len(x), len(y)
+	var edits []edit
+	if n*m > maxDiffCells {
+		for k := 0; k < n || k < m; k++ {
+			switch {
+			case k < n && k < m:
+				edits = append(edits, edit{kind: editChange, i: offset + k, j: offset + k})
+			case k < n:
+				edits = append(edits, edit{kind: editRemove, i: offset + k})
+			default:
+				edits = append(edits, edit{kind: editAdd, j: offset + k})
+			}
+		}
+		return edits
+	}
+
+	// cost[i][j] is count of edits of x[i:] into y[j:]
+	cost := make([][]int, n+1)
+	for i := range cost {
+		cost[i] = make([]int, m+1)
+		cost[i][m] = n - i
+	}
+	for j := 0; j <= m; j++ {
+		cost[n][j] = m - j
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			c := cost[i+1][j+1]
+			if !jsondoc.Equal(x[i].Data, y[j].Data) {
+				c++
+			}
+			c = min(c, cost[i+1][j]+1, cost[i][j+1]+1)
+			cost[i][j] = c
+		}
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && jsondoc.Equal(x[i].Data, y[j].Data) && cost[i][j] == cost[i+1][j+1]:
+			edits = append(edits, edit{kind: editKeep, i: offset + i, j: offset + j})
+			i++
+			j++
+		case i < n && j < m && cost[i][j] == cost[i+1][j+1]+1:
+			edits = append(edits, edit{kind: editChange, i: offset + i, j: offset + j})
+			i++
+			j++
+		case i < n && cost[i][j] == cost[i+1][j]+1:
+			edits = append(edits, edit{kind: editRemove, i: offset + i})
+			i++
+		default:
+			edits = append(edits, edit{kind: editAdd, j: offset + j})
+			j++
+		}
+	}
+	return edits
+}
@@ -0,0 +1,62 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		except string
+		descr  string
+	}{
+		{`{"a": 1}`, `{"a": 1.0}`, `[]`, "equal"},
+		{`{"a": 1, "b": 2}`, `{"b": 3, "c": 4}`, `[{"op":"remove","path":"/a"},{"op":"replace","path":"/b","value":3},{"op":"add","path":"/c","value":4}]`, "object"},
+		{`{"a": {"x": [1, 2]}}`, `{"a": {"x": [1, 2, 3]}}`, `[{"op":"add","path":"/a/x/2","value":3}]`, "nested append"},
+		{`[1, 2, 3, 4]`, `[1, 3, 4]`, `[{"op":"remove","path":"/1"}]`, "remove in middle"},
+		{`[1, 2, 3]`, `[0, 1, 2, 3]`, `[{"op":"add","path":"/0","value":0}]`, "insert at start"},
+		{`[1, 5, 2, 6, 3]`, `[1, 2, 7, 3]`, `[{"op":"remove","path":"/1"},{"op":"replace","path":"/2","value":7}]`, "remove & change"},
+		{`[1, 2, 3, 4, 5]`, `[5, 4, 3, 2, 1]`, `[{"op":"replace","path":"/0","value":5},{"op":"replace","path":"/1","value":4},{"op":"replace","path":"/3","value":2},{"op":"replace","path":"/4","value":1}]`, "reverse"},
+		{`[{"id": 1, "v": "a"}, {"id": 2}]`, `[{"id": 1, "v": "b"}, {"id": 2}]`, `[{"op":"replace","path":"/0/v","value":"b"}]`, "changed element is diffed"},
+		{`{"a/b": [1]}`, `{"a/b": {}}`, `[{"op":"replace","path":"/a~1b","value":{}}]`, "escaped path & type change"},
+		{`1`, `"1"`, `[{"op":"replace","path":"","value":"1"}]`, "root"},
+	}
+
+	for _, v := range tests {
+		a, b := mustParse(t, v.a), mustParse(t, v.b)
+		patch := Diff(a, b)
+		s, _ := Stringify(patch, nil, nil)
+		TestLog("Diff", t, v.a+" -> "+v.b, s, v.except, v.descr)
+
+		got, err := Patch(a, patch)
+		TestLog("Diff", t, v.a+" -> "+v.b, err, nil, v.descr)
+		TestLog("Diff", t, v.a+" -> "+v.b, jsondoc.Equal(got, b), true, "patch of diff give b")
+	}
+}
+
+func TestDiffMinimal(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		count int
+		descr string
+	}{
+		{`[1, 2, 3, 4, 5]`, `[5, 4, 3, 2, 1]`, 4, "changes across kept element"},
+		{`[1, 2, 3]`, `[4, 5, 6]`, 3, "all changed"},
+		{`[1, 2, 3]`, `[2, 3, 4]`, 2, "shift"},
+		{`[1, 2, 3, 4]`, `[5, 2, 6]`, 3, "change, change & remove"},
+		{`[1, 2]`, `[3, 1, 4, 2, 5]`, 3, "adds around kept elements"},
+		{`["a", "b", "c"]`, `["x", "b", "y", "z"]`, 3, "change & add"},
+	}
+
+	for _, v := range tests {
+		a, b := mustParse(t, v.a), mustParse(t, v.b)
+		patch := Diff(a, b)
+		TestLog("Diff", t, v.a+" -> "+v.b, len(patch.Items), v.count, v.descr)
+
+		got, err := Patch(a, patch)
+		TestLog("Diff", t, v.a+" -> "+v.b, err, nil, v.descr)
+		TestLog("Diff", t, v.a+" -> "+v.b, jsondoc.Equal(got, b), true, "patch of diff give b")
+	}
+}
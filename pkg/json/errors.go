@@ -0,0 +1,39 @@
+package json
+
+import "github.com/miron-developer/golang-js-utils/pkg/array"
+
+// SyntaxError is error of invalid JSON text, like js SyntaxError of JSON.parse
+// 	Offset is position in UTF-16 code units like in js; Line & Column start from 1
+type SyntaxError struct {
+	Message string
+	Offset  int
+	Line    int
+	Column  int
+}
+
+func (e *SyntaxError) Error() string {
+	return "SyntaxError: " + e.Message
+}
+
+// Unwrap return array.SyntaxError of message, so errors.As catch it like other SyntaxErrors
+func (e *SyntaxError) Unwrap() error {
+	return &array.SyntaxError{Message: e.Message}
+}
+
+// PointerError is error of JSON pointer which can't be resolved in document
+type PointerError struct {
+	Message string
+}
+
+func (e *PointerError) Error() string {
+	return "PointerError: " + e.Message
+}
+
+// PatchError is error of invalid JSON Patch operation or failed test operation
+type PatchError struct {
+	Message string
+}
+
+func (e *PatchError) Error() string {
+	return "PatchError: " + e.Message
+}
@@ -0,0 +1,265 @@
+package json
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// ParseJSON5 return value of JSON5 text, like JSON5.parse
+// 	values are like in Parse; JSON5 allows comments, trailing commas, identifier keys,
+// 	single-quoted strings, hex numbers, Infinity, NaN, leading or trailing decimal point & plus sign
+// 	return SyntaxError with position, line & column like Parse
+func ParseJSON5(text string, reviver Reviver) (interface{}, error) {
+	return parse(text, reviver, syntax{comments: true, trailingCommas: true, json5: true})
+}
+
+// ParseJSONC return value of JSON with comments, like jsconfig & tsconfig files
+// 	values are like in Parse; JSONC allows // & /* */ comments & trailing commas
+// 	return SyntaxError with position, line & column like Parse
+func ParseJSONC(text string, reviver Reviver) (interface{}, error) {
+	return parse(text, reviver, syntax{comments: true, trailingCommas: true})
+}
+
+// StringifyJSON5 return JSON5 of v, like JSON5.stringify
+// 	values are converted like in Stringify, but NaN & Infinity are kept,
+// 	keys are unquoted if they are identifiers & strings are quoted with single quotes
+// 	unless they have more single quotes than double; indented objects have trailing commas
+func StringifyJSON5(v interface{}, replacer interface{}, space interface{}) (string, error) {
+	return stringify(v, replacer, space, true)
+}
+
+func isJSON5Space(c uint16) bool {
+	switch c {
+	case '\v', '\f', 0xa0, 0x2028, 0x2029, 0xfeff:
+		return true
+	}
+	return unicode.Is(unicode.Zs, rune(c))
+}
+
+func isLineTerminator(c uint16) bool {
+	return c == '\n' || c == '\r' || c == 0x2028 || c == 0x2029
+}
+
+// comment move to last char of comment at current position
+// 	return false if there is no comment or block comment is unterminated
+func (p *parser) comment() bool {
+	if p.pos+1 >= len(p.u) {
+		return false
+	}
+	switch p.u[p.pos+1] {
+	case '/':
+		for p.pos+1 < len(p.u) && !isLineTerminator(p.u[p.pos+1]) {
+			p.pos++
+		}
+		return true
+	case '*':
+		end := p.u[p.pos+2:].Index(jsstring.New("*/"), 0)
+		if end < 0 {
+			return false
+		}
+		p.pos += 2 + end + 1
+		return true
+	}
+	return false
+}
+
+// codePoint return code point at current position & its length in code units
+func (p *parser) codePoint() (rune, int) {
+	r, ok := p.u.CodePointAt(p.pos)
+	if !ok {
+		return -1, 0
+	}
+	if r > 0xffff {
+		return r, 2
+	}
+	return r, 1
+}
+
+// identifierStart check is identifier at current position, like ECMAScript IdentifierStart
+func (p *parser) identifierStart() bool {
+	if c, _ := p.peek(); c == '\\' {
+		return true
+	}
+	r, _ := p.codePoint()
+	return isIdentifierChar(r, true)
+}
+
+func isIdentifierChar(r rune, start bool) bool {
+	if r == '$' || r == '_' || unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) {
+		return true
+	}
+	if start {
+		return false
+	}
+	return unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc) || r == 0x200c || r == 0x200d
+}
+
+// identifier return identifier key of JSON5 object with unicode escapes
+func (p *parser) identifier() (string, error) {
+	var buf jsstring.JSString
+	for start := true; p.pos < len(p.u); start = false {
+		if p.u[p.pos] == '\\' {
+			escape := p.pos
+			p.pos++
+			if c, _ := p.peek(); c != 'u' {
+				return "", p.fail("Bad escaped character")
+			}
+			p.pos++
+			h, err := p.hex(4, "Bad Unicode escape")
+			if err != nil {
+				return "", err
+			}
+			if !isIdentifierChar(rune(h), start) {
+				p.pos = escape
+				return "", p.fail("Bad Unicode escape")
+			}
+			buf = append(buf, h)
+			continue
+		}
+		r, n := p.codePoint()
+		if !isIdentifierChar(r, start) {
+			break
+		}
+		buf = append(buf, p.u[p.pos:p.pos+n]...)
+		p.pos += n
+	}
+	return buf.String(), nil
+}
+
+// escapeJSON5 append JSON5 escape sequence of char c after backslash to buf
+// 	escaped line terminator is line continuation & other chars are escaped as itself
+func (p *parser) escapeJSON5(buf *jsstring.JSString, c uint16) error {
+	switch {
+	case c == '0':
+		if d, _ := p.peek(); isDigit(d) {
+			return p.fail("Bad escaped character")
+		}
+		*buf = append(*buf, 0)
+	case isDigit(c):
+		p.pos--
+		return p.fail("Bad escaped character")
+	case c == 'v':
+		*buf = append(*buf, '\v')
+	case c == 'x':
+		h, err := p.hex(2, "Bad hex escape")
+		if err != nil {
+			return err
+		}
+		*buf = append(*buf, h)
+	case c == '\r':
+		if d, _ := p.peek(); d == '\n' {
+			p.pos++
+		}
+	case isLineTerminator(c):
+	default:
+		*buf = append(*buf, c)
+	}
+	return nil
+}
+
+// numberJSON5 return Infinity, NaN or hex number after sign
+// 	ok is false for decimal numbers
+func (p *parser) numberJSON5(start int) (f interface{}, ok bool, err error) {
+	sign := 1.0
+	if p.u[start] == '-' {
+		sign = -1
+	}
+	c, _ := p.peek()
+	switch {
+	case c == 'I':
+		return sign * math.Inf(1), true, p.literal("Infinity")
+	case c == 'N':
+		return math.NaN(), true, p.literal("NaN")
+	case c == '0' && p.pos+1 < len(p.u) && (p.u[p.pos+1] == 'x' || p.u[p.pos+1] == 'X'):
+		p.pos += 2
+		n := 0.0
+		digits := 0
+		for ; p.pos < len(p.u); p.pos++ {
+			h, valid := hexDigit(p.u[p.pos])
+			if !valid {
+				break
+			}
+			n = n*16 + float64(h)
+			digits++
+		}
+		if digits == 0 {
+			return nil, true, p.fail("No number after hex prefix")
+		}
+		return sign * n, true, nil
+	}
+	return nil, false, nil
+}
+
+// quoteJSON5 write s as JSON5 string, like JSON5.stringify
+// 	quote is single unless s has more single quotes than double
+func quoteJSON5(buf *bytes.Buffer, s string) {
+	quote := byte('\'')
+	if strings.Count(s, "'") > strings.Count(s, `"`) {
+		quote = '"'
+	}
+	buf.WriteByte(quote)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == quote || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c == '\b':
+			buf.WriteString(`\b`)
+		case c == '\f':
+			buf.WriteString(`\f`)
+		case c == '\n':
+			buf.WriteString(`\n`)
+		case c == '\r':
+			buf.WriteString(`\r`)
+		case c == '\t':
+			buf.WriteString(`\t`)
+		case c == '\v':
+			buf.WriteString(`\v`)
+		case c == 0:
+			if i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9' {
+				buf.WriteString(`\x00`)
+			} else {
+				buf.WriteString(`\0`)
+			}
+		case c < 0x20:
+			buf.WriteString(`\x`)
+			buf.WriteByte(hex[c>>4])
+			buf.WriteByte(hex[c&0xf])
+		case c == 0xe2 && i+2 < len(s) && s[i+1] == 0x80 && (s[i+2] == 0xa8 || s[i+2] == 0xa9):
+			// line & paragraph separators
+			buf.WriteString(`\u202`)
+			buf.WriteByte(hex[s[i+2]-0xa0])
+			i += 2
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte(quote)
+}
+
+// keyJSON5 write property name, unquoted if it is identifier
+func keyJSON5(buf *bytes.Buffer, k string) {
+	if !isIdentifier(k) {
+		quoteJSON5(buf, k)
+		return
+	}
+	buf.WriteString(k)
+}
+
+func isIdentifier(s string) bool {
+	if s == "" || !utf8.ValidString(s) {
+		return false
+	}
+	for i, r := range s {
+		if !isIdentifierChar(r, i == 0) {
+			return false
+		}
+	}
+	return true
+}
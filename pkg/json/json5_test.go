@@ -0,0 +1,123 @@
+package json
+
+import (
+	"math"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+func TestParseJSON5(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   interface{}
+		descr    string
+	}{
+		{"// config\n{a: 1, /* b */ 'b': 'x', \"c\": [1, 2,],}", ordered("a", float64(1), "b", "x", "c", array.MakeArray(float64(1), float64(2))), "comments & trailing commas"},
+		{`{$id: 1, _x2: 2, café: 3, ab: 4}`, ordered("$id", float64(1), "_x2", float64(2), "café", float64(3), "ab", float64(4)), "identifier keys"},
+		{`'it\'s "ok"'`, `it's "ok"`, "single quotes"},
+		{`'\x41\v\0\q\
+b'`, "A\v\x00qb", "escapes & line continuation"},
+		{`[0x1F, -0Xff, +1, .5, 5., 1e2, +Infinity, -Infinity]`, array.MakeArray(float64(31), float64(-255), float64(1), 0.5, float64(5), float64(100), math.Inf(1), math.Inf(-1)), "numbers"},
+		{"\u00a0\ufeff[\u2028null\u2029]\v", array.MakeArray(nil), "unicode whitespace"},
+		{`/* only */ {} /* comment */`, jsmap.New(), "block comments"},
+	}
+
+	for _, v := range tests {
+		got, err := ParseJSON5(v.incoming, nil)
+		if err != nil {
+			t.Errorf("ParseJSON5:(%v) error %v. Test: %v\n", v.incoming, err, v.descr)
+			continue
+		}
+		TestLog("ParseJSON5", t, v.incoming, got, v.except, v.descr)
+	}
+
+	got, _ := ParseJSON5(`NaN`, nil)
+	f, _ := got.(float64)
+	TestLog("ParseJSON5", t, "NaN", math.IsNaN(f), true, "NaN")
+}
+
+func TestParseJSONC(t *testing.T) {
+	got, err := ParseJSONC("{\n  // target\n  \"target\": \"es2020\", /* libs */\n  \"lib\": [\"dom\",],\n}", nil)
+	TestLog("ParseJSONC", t, "", err, nil, "no error")
+	TestLog("ParseJSONC", t, "", got, ordered("target", "es2020", "lib", array.MakeArray("dom")), "comments & trailing commas")
+
+	tests := []struct {
+		incoming string
+		except   string
+		descr    string
+	}{
+		{`{a: 1}`, "Expected property name or '}' in JSON at position 1 (line 1 column 2)", "identifier key"},
+		{`'a'`, `Unexpected token ''', "'a'" is not valid JSON`, "single quotes"},
+		{`[1] /* open`, "Unexpected non-whitespace character after JSON at position 4 (line 1 column 5)", "unterminated comment"},
+		{`[0x1]`, "Expected ',' or ']' after array element in JSON at position 2 (line 1 column 3)", "hex number"},
+	}
+	for _, v := range tests {
+		_, err := ParseJSONC(v.incoming, nil)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("ParseJSONC", t, v.incoming, msg, "SyntaxError: "+v.except, v.descr)
+	}
+}
+
+func TestParseJSON5Error(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   string
+		descr    string
+	}{
+		{"{\n  a: 1\n  b: 2\n}", "Expected ',' or '}' after property value in JSON at position 11 (line 3 column 3)", "missing comma"},
+		{`{1a: 1}`, "Expected property name or '}' in JSON at position 1 (line 1 column 2)", "bad identifier"},
+		{`'a
+b'`, "Bad control character in string literal in JSON at position 2 (line 1 column 3)", "line terminator in string"},
+		{`'\1'`, "Bad escaped character in JSON at position 2 (line 1 column 3)", "octal escape"},
+		{`'\xg0'`, "Bad hex escape in JSON at position 3 (line 1 column 4)", "bad hex escape"},
+		{`0x`, "No number after hex prefix in JSON at position 2 (line 1 column 3)", "empty hex"},
+		{`Infinit`, "Unexpected end of JSON input", "bad Infinity"},
+		{`[1,,]`, `Unexpected token ',', "[1,,]" is not valid JSON`, "double comma"},
+		{"/* a\u2028b */ x", `Unexpected token 'x', "/* a` + "\u2028" + `b */ x" is not valid JSON`, "unexpected token after comment"},
+	}
+	for _, v := range tests {
+		_, err := ParseJSON5(v.incoming, nil)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("ParseJSON5Error", t, v.incoming, msg, "SyntaxError: "+v.except, v.descr)
+	}
+
+	_, err := ParseJSON5("/* a\u2028b */ x", nil)
+	se, _ := err.(*SyntaxError)
+	TestLog("ParseJSON5Error", t, "", []int{se.Offset, se.Line, se.Column}, []int{10, 2, 6}, "line separator is line break")
+}
+
+func TestStringifyJSON5(t *testing.T) {
+	tests := []struct {
+		incoming interface{}
+		space    interface{}
+		except   string
+		descr    string
+	}{
+		{ordered("a", float64(1), "b-c", "x", "", true, "$_1", nil), nil, `{a:1,'b-c':'x','':true,$_1:null}`, "keys"},
+		{[]interface{}{math.NaN(), math.Inf(1), math.Inf(-1), -0.0}, nil, `[NaN,Infinity,-Infinity,0]`, "numbers"},
+		{`it's`, nil, `"it's"`, "more single quotes"},
+		{`"a" 'b'`, nil, `'"a" \'b\''`, "equal quotes"},
+		{"\b\f\n\r\t\v\x00a\x001\x01\u2028\u2029é", nil, `'\b\f\n\r\t\v\0a\x001\x01\u2028\u2029é'`, "escapes"},
+		{ordered("a", array.MakeArray(float64(1), ordered())), 2, "{\n  a: [\n    1,\n    {},\n  ],\n}", "indent with trailing commas"},
+		{array.NewArray(), 2, "[]", "empty array"},
+	}
+
+	for _, v := range tests {
+		got, err := StringifyJSON5(v.incoming, nil, v.space)
+		TestLog("StringifyJSON5", t, v.incoming, err, nil, v.descr)
+		TestLog("StringifyJSON5", t, v.incoming, got, v.except, v.descr)
+	}
+
+	text, _ := StringifyJSON5(ordered("a", "it's", "b", array.MakeArray(math.Inf(1))), nil, 2)
+	back, err := ParseJSON5(text, nil)
+	TestLog("StringifyJSON5", t, text, err, nil, "round trip")
+	TestLog("StringifyJSON5", t, text, back, ordered("a", "it's", "b", array.MakeArray(math.Inf(1))), "round trip")
+}
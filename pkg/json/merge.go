@@ -0,0 +1,45 @@
+package json
+
+import (
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+// MergePatch return target with merge patch applied, like RFC 7386
+// 	object patch set its members into target object recursively, null member delete member;
+// 	other patch replace target, non-object target is replaced by new *jsmap.Map
+// 	target isn't modified, changed objects are copied & unchanged values are shared
+func MergePatch(target, patch interface{}) interface{} {
+	if !jsondoc.IsObject(patch) {
+		return patch
+	}
+
+	var r interface{}
+	switch t := target.(type) {
+	case *jsmap.Map:
+		m := jsmap.New()
+		t.ForEach(func(v, k interface{}, _ *jsmap.Map) {
+			m.Set(k, v)
+		})
+		r = m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			m[k] = v
+		}
+		r = m
+	default:
+		r = jsmap.New()
+	}
+
+	for _, k := range jsondoc.MemberKeys(patch) {
+		v, _ := jsondoc.Member(patch, k)
+		if v == nil {
+			jsondoc.DeleteMember(r, k)
+			continue
+		}
+		cur, _ := jsondoc.Member(r, k)
+		jsondoc.SetMember(r, k, MergePatch(cur, v))
+	}
+	return r
+}
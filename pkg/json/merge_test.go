@@ -0,0 +1,41 @@
+package json
+
+import (
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	// examples of RFC 7386 appendix A
+	tests := []struct {
+		target string
+		patch  string
+		except string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, v := range tests {
+		target := mustParse(t, v.target)
+		got, _ := Stringify(MergePatch(target, mustParse(t, v.patch)), nil, nil)
+		TestLog("MergePatch", t, v.patch, got, v.except, v.target)
+		after, _ := Stringify(target, nil, nil)
+		TestLog("MergePatch", t, v.patch, after, v.target, "target isn't modified")
+	}
+
+	got := MergePatch(map[string]interface{}{"a": 1, "b": 2}, map[string]interface{}{"b": nil, "c": 3})
+	TestLog("MergePatch", t, "", got, map[string]interface{}{"a": 1, "c": 3}, "go maps")
+}
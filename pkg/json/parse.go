@@ -0,0 +1,467 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+// Reviver is reviver function of Parse, like js JSON.parse reviver
+// 	key is "" for root value; returning value.Undefined delete property
+type Reviver func(key string, value interface{}) interface{}
+
+// Parse return value of JSON text, like js JSON.parse
+// 	arrays are *array.Array, objects are *jsmap.Map with string keys in insertion order,
+// 	numbers are float64, strings are string with lone surrogates kept as WTF-8, null is nil
+// 	duplicate keys keep first position & last value, like in js
+// 	reviver is called bottom-up for every property & element, then for root with key "";
+// 	deleted array elements are value.Undefined, like js holes
+// 	return SyntaxError with position, line & column like V8 messages
+func Parse(text string, reviver Reviver) (interface{}, error) {
+	return parse(text, reviver, syntax{})
+}
+
+func parse(text string, reviver Reviver, s syntax) (interface{}, error) {
+	p := &parser{u: jsstring.New(text), syntax: s}
+	p.skipSpace()
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.u) {
+		return nil, p.errorAt("Unexpected non-whitespace character after JSON")
+	}
+	if reviver != nil {
+		return revive("", v, reviver), nil
+	}
+	return v, nil
+}
+
+// revive call reviver for v & its children bottom-up, like js InternalizeJSONProperty
+func revive(key string, v interface{}, reviver Reviver) interface{} {
+	switch t := v.(type) {
+	case *array.Array:
+		// length is read once, like in js
+		n := len(t.Items)
+		for i := 0; i < n && i < len(t.Items); i++ {
+			t.Items[i].Data = revive(strconv.Itoa(i), t.Items[i].Data, reviver)
+		}
+	case *jsmap.Map:
+		keys := t.KeysArray()
+		for _, item := range keys.Items {
+			k := item.Data.(string)
+			cur, _ := t.Get(k)
+			if r := revive(k, cur, reviver); isUndefined(r) {
+				t.Delete(k)
+			} else {
+				t.Set(k, r)
+			}
+		}
+	}
+	return reviver(key, v)
+}
+
+func isUndefined(v interface{}) bool {
+	val, ok := v.(value.Value)
+	return ok && val.IsUndefined()
+}
+
+type parser struct {
+	u   jsstring.JSString
+	pos int
+	// origin is position of text in stream, see Decoder
+	origin position
+	syntax syntax
+}
+
+// syntax is extensions of JSON syntax, see ParseJSON5 & ParseJSONC
+type syntax struct {
+	comments       bool
+	trailingCommas bool
+	json5          bool
+}
+
+// position is offset in UTF-16 code units with lines & columns before it
+type position struct {
+	offset, line, column int
+}
+
+func (p *parser) peek() (uint16, bool) {
+	if p.pos < len(p.u) {
+		return p.u[p.pos], true
+	}
+	return 0, false
+}
+
+func (p *parser) skipSpace() {
+	for ; p.pos < len(p.u); p.pos++ {
+		switch c := p.u[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		case p.syntax.json5 && isJSON5Space(c):
+		case p.syntax.comments && c == '/' && p.comment():
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) value() (interface{}, error) {
+	c, ok := p.peek()
+	if !ok {
+		return nil, p.unexpected()
+	}
+	switch {
+	case c == '{':
+		return p.object()
+	case c == '[':
+		return p.array()
+	case c == '"', p.syntax.json5 && c == '\'':
+		return p.string()
+	case c == '-' || c >= '0' && c <= '9':
+		return p.number()
+	case p.syntax.json5 && (c == '+' || c == '.' || c == 'I' || c == 'N'):
+		return p.number()
+	case c == 't':
+		return true, p.literal("true")
+	case c == 'f':
+		return false, p.literal("false")
+	case c == 'n':
+		return nil, p.literal("null")
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) literal(word string) error {
+	for i := 0; i < len(word); i++ {
+		if c, ok := p.peek(); !ok || c != uint16(word[i]) {
+			return p.unexpected()
+		}
+		p.pos++
+	}
+	return nil
+}
+
+func (p *parser) object() (interface{}, error) {
+	m := jsmap.New()
+	p.pos++
+	p.skipSpace()
+	if c, _ := p.peek(); c == '}' {
+		p.pos++
+		return m, nil
+	}
+	for first := true; ; first = false {
+		k, err := p.key(first)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if c, _ := p.peek(); c != ':' {
+			return nil, p.fail("Expected ':' after property name")
+		}
+		p.pos++
+		p.skipSpace()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		m.Set(k, v)
+
+		p.skipSpace()
+		switch c, _ := p.peek(); c {
+		case ',':
+			p.pos++
+			p.skipSpace()
+			if c, _ := p.peek(); c == '}' && p.syntax.trailingCommas {
+				p.pos++
+				return m, nil
+			}
+		case '}':
+			p.pos++
+			return m, nil
+		default:
+			return nil, p.fail("Expected ',' or '}' after property value")
+		}
+	}
+}
+
+func (p *parser) array() (interface{}, error) {
+	arr := array.NewArray()
+	p.pos++
+	p.skipSpace()
+	if c, _ := p.peek(); c == ']' {
+		p.pos++
+		return arr, nil
+	}
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		arr.Items = append(arr.Items, array.ArrayItem{Data: v})
+
+		p.skipSpace()
+		switch c, _ := p.peek(); c {
+		case ',':
+			p.pos++
+			p.skipSpace()
+			if c, _ := p.peek(); c == ']' && p.syntax.trailingCommas {
+				p.pos++
+				return arr, nil
+			}
+		case ']':
+			p.pos++
+			return arr, nil
+		default:
+			return nil, p.fail("Expected ',' or ']' after array element")
+		}
+	}
+}
+
+// key return property name of object
+func (p *parser) key(first bool) (string, error) {
+	c, _ := p.peek()
+	switch {
+	case c == '"', p.syntax.json5 && c == '\'':
+		return p.string()
+	case p.syntax.json5 && p.identifierStart():
+		return p.identifier()
+	case first:
+		return "", p.fail("Expected property name or '}'")
+	}
+	return "", p.fail("Expected double-quoted property name")
+}
+
+// string return string starting at quote
+func (p *parser) string() (string, error) {
+	quote := p.u[p.pos]
+	p.pos++
+	start := p.pos
+	var buf jsstring.JSString
+	escaped := false
+	for {
+		c, ok := p.peek()
+		switch {
+		case !ok:
+			return "", p.fail("Unterminated string")
+		case c == quote:
+			p.pos++
+			if !escaped {
+				return p.u[start : p.pos-1].String(), nil
+			}
+			return buf.String(), nil
+		case c < 0x20 && !p.syntax.json5, c == '\n' || c == '\r':
+			return "", p.fail("Bad control character in string literal")
+		case c == '\\':
+			if !escaped {
+				escaped = true
+				buf = append(buf, p.u[start:p.pos]...)
+			}
+			p.pos++
+			if err := p.escape(&buf); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if escaped {
+			buf = append(buf, c)
+		}
+		p.pos++
+	}
+}
+
+// escape append code unit of escape sequence after backslash to buf
+func (p *parser) escape(buf *jsstring.JSString) error {
+	c, ok := p.peek()
+	if !ok {
+		return p.fail("Unterminated string")
+	}
+	p.pos++
+	var r uint16
+	switch c {
+	case '"', '\\', '/':
+		r = c
+	case 'b':
+		r = '\b'
+	case 'f':
+		r = '\f'
+	case 'n':
+		r = '\n'
+	case 'r':
+		r = '\r'
+	case 't':
+		r = '\t'
+	case 'u':
+		h, err := p.hex(4, "Bad Unicode escape")
+		if err != nil {
+			return err
+		}
+		r = h
+	default:
+		if !p.syntax.json5 {
+			p.pos--
+			return p.fail("Bad escaped character")
+		}
+		return p.escapeJSON5(buf, c)
+	}
+	*buf = append(*buf, r)
+	return nil
+}
+
+// hex return value of n hex digits
+func (p *parser) hex(n int, message string) (uint16, error) {
+	var r uint16
+	for i := 0; i < n; i++ {
+		d, ok := p.peek()
+		h, valid := hexDigit(d)
+		if !ok || !valid {
+			return 0, p.fail(message)
+		}
+		r = r<<4 | h
+		p.pos++
+	}
+	return r, nil
+}
+
+func hexDigit(c uint16) (uint16, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+func (p *parser) number() (interface{}, error) {
+	start := p.pos
+	if c, _ := p.peek(); c == '-' || c == '+' && p.syntax.json5 {
+		p.pos++
+	}
+	if p.syntax.json5 {
+		if f, ok, err := p.numberJSON5(start); ok || err != nil {
+			return f, err
+		}
+	}
+	integer := true
+	switch c, _ := p.peek(); {
+	case c == '0':
+		p.pos++
+		if d, _ := p.peek(); isDigit(d) {
+			return nil, p.fail("Unexpected number")
+		}
+	case isDigit(c):
+		p.digits()
+	case c == '.' && p.syntax.json5:
+		// .5 is number in JSON5
+		integer = false
+	default:
+		return nil, p.fail("No number after minus sign")
+	}
+	if c, _ := p.peek(); c == '.' {
+		p.pos++
+		d, _ := p.peek()
+		if !isDigit(d) && !(p.syntax.json5 && integer) {
+			return nil, p.fail("Unterminated fractional number")
+		}
+		p.digits()
+	}
+	if c, _ := p.peek(); c == 'e' || c == 'E' {
+		p.pos++
+		if s, _ := p.peek(); s == '+' || s == '-' {
+			p.pos++
+		}
+		if d, _ := p.peek(); !isDigit(d) {
+			return nil, p.fail("Exponent part is missing a number")
+		}
+		p.digits()
+	}
+	// out of range numbers are ±Infinity & 0, like in js
+	f, _ := strconv.ParseFloat(p.u[start:p.pos].String(), 64)
+	return f, nil
+}
+
+func isDigit(c uint16) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *parser) digits() {
+	for d, _ := p.peek(); isDigit(d); d, _ = p.peek() {
+		p.pos++
+	}
+}
+
+// location return offset, line & column of current position, like V8
+// 	\r\n, \r & \n are line breaks
+func (p *parser) location() (offset, line, column int) {
+	line = 1
+	lineStart := 0
+	for i := 0; i < p.pos; i++ {
+		c := p.u[i]
+		if c == '\r' && i+1 < p.pos && p.u[i+1] == '\n' {
+			i++
+			c = '\n'
+		}
+		if c == '\r' || c == '\n' || p.syntax.json5 && (c == 0x2028 || c == 0x2029) {
+			line++
+			lineStart = i + 1
+		}
+	}
+	column = p.pos - lineStart + 1
+	if line == 1 {
+		column += p.origin.column
+	}
+	return p.origin.offset + p.pos, p.origin.line + line, column
+}
+
+// fail return SyntaxError of message in JSON at current position
+func (p *parser) fail(message string) error {
+	return p.errorAt(message + " in JSON")
+}
+
+// errorAt return SyntaxError of message with current position
+func (p *parser) errorAt(message string) error {
+	offset, line, column := p.location()
+	return &SyntaxError{
+		Message: fmt.Sprintf("%s at position %d (line %d column %d)", message, offset, line, column),
+		Offset:  offset,
+		Line:    line,
+		Column:  column,
+	}
+}
+
+// contextChars is number of chars around unexpected token in error message, like in V8
+const contextChars = 10
+
+// unexpected return SyntaxError of unexpected token or end of input at current position
+// 	source of short text is quoted whole, otherwise 10 chars around token, like V8
+func (p *parser) unexpected() error {
+	offset, line, column := p.location()
+	err := &SyntaxError{Offset: offset, Line: line, Column: column}
+	if p.pos >= len(p.u) {
+		err.Message = "Unexpected end of JSON input"
+		return err
+	}
+
+	token := p.u[p.pos : p.pos+1].String()
+	n := len(p.u)
+	var source string
+	switch {
+	case n <= 2*contextChars+1:
+		source = `"` + p.u.String() + `"`
+	case p.pos < contextChars:
+		source = `"` + p.u[:p.pos+contextChars].String() + `"...`
+	case p.pos < n-contextChars:
+		source = `..."` + p.u[p.pos-contextChars:p.pos+contextChars].String() + `"...`
+	default:
+		source = `..."` + p.u[p.pos-contextChars:].String() + `"`
+	}
+	err.Message = fmt.Sprintf("Unexpected token '%s', %s is not valid JSON", token, source)
+	return err
+}
@@ -0,0 +1,119 @@
+package json
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   interface{}
+		descr    string
+	}{
+		{`null`, nil, "null"},
+		{` true `, true, "true with whitespace"},
+		{`false`, false, "false"},
+		{`-0.5e2`, float64(-50), "number"},
+		{`1e400`, math.Inf(1), "overflow is Infinity"},
+		{`"a\"\\\/\b\f\n\r\té"`, "a\"\\/\b\f\n\r\té", "escapes"},
+		{`"😀"`, "😀", "surrogate pair"},
+		{`"\ud800"`, jsstring.JSString{0xd800}.String(), "lone surrogate"},
+		{`"é😀"`, "é😀", "non-ascii"},
+		{`[]`, array.NewArray(), "empty array"},
+		{`[1, "a", [null]]`, array.MakeArray(float64(1), "a", array.MakeArray(nil)), "array"},
+		{`{}`, jsmap.New(), "empty object"},
+		{`{"b": 1, "a": {"c": []}}`, ordered("b", float64(1), "a", ordered("c", array.NewArray())), "object in insertion order"},
+		{`{"2": 1, "1": 2}`, ordered("2", float64(1), "1", float64(2)), "integer keys in insertion order"},
+		{`{"a": 1, "b": 2, "a": 3}`, ordered("a", float64(3), "b", float64(2)), "duplicate key"},
+	}
+
+	for _, v := range tests {
+		got, err := Parse(v.incoming, nil)
+		if err != nil {
+			t.Errorf("Parse:(%v) error %v. Test: %v\n", v.incoming, err, v.descr)
+			continue
+		}
+		TestLog("Parse", t, v.incoming, got, v.except, v.descr)
+	}
+}
+
+func TestParseKeyOrder(t *testing.T) {
+	got, _ := Parse(`{"z": 1, "a": 2, "m": {"y": 1, "b": 2}}`, nil)
+	s, _ := Stringify(got, nil, nil)
+	TestLog("ParseKeyOrder", t, "", s, `{"z":1,"a":2,"m":{"y":1,"b":2}}`, "round trip keeps key order")
+}
+
+func TestParseReviver(t *testing.T) {
+	var visited []string
+	got, err := Parse(`{"a": [1, 2, {"b": 3}], "c": 4}`, func(key string, v interface{}) interface{} {
+		visited = append(visited, key)
+		if f, ok := v.(float64); ok {
+			if f == 2 || key == "c" {
+				return value.Undefined
+			}
+			return f * 10
+		}
+		return v
+	})
+	TestLog("ParseReviver", t, "", err, nil, "no error")
+	TestLog("ParseReviver", t, "", visited, []string{"0", "1", "b", "2", "a", "c", ""}, "bottom-up order")
+	except := ordered("a", array.MakeArray(float64(10), value.Undefined, ordered("b", float64(30))))
+	TestLog("ParseReviver", t, "", got, except, "undefined delete property & make hole")
+
+	got, _ = Parse(`1`, func(key string, v interface{}) interface{} { return value.Undefined })
+	TestLog("ParseReviver", t, "", got, value.Undefined, "undefined root")
+}
+
+func TestParseError(t *testing.T) {
+	long := `{"name": "value", "list": [1, 2, x, 4], "more": true}`
+	tests := []struct {
+		incoming string
+		except   string
+		descr    string
+	}{
+		{``, "Unexpected end of JSON input", "empty"},
+		{`[1,`, "Unexpected end of JSON input", "end of input"},
+		{`[1,]`, `Unexpected token ']', "[1,]" is not valid JSON`, "trailing comma in array"},
+		{`tx`, `Unexpected token 'x', "tx" is not valid JSON`, "bad literal"},
+		{`{'a': 1}`, "Expected property name or '}' in JSON at position 1 (line 1 column 2)", "single quotes"},
+		{`{"a": 1,}`, "Expected double-quoted property name in JSON at position 8 (line 1 column 9)", "trailing comma in object"},
+		{`{"a" 1}`, "Expected ':' after property name in JSON at position 5 (line 1 column 6)", "no colon"},
+		{`{"a": 1 "b": 2}`, "Expected ',' or '}' after property value in JSON at position 8 (line 1 column 9)", "no comma in object"},
+		{"[1,\n 2\r\n 3]", "Expected ',' or ']' after array element in JSON at position 9 (line 3 column 2)", "no comma in array"},
+		{`1 2`, "Unexpected non-whitespace character after JSON at position 2 (line 1 column 3)", "extra value"},
+		{`01`, "Unexpected number in JSON at position 1 (line 1 column 2)", "leading zero"},
+		{`-a`, "No number after minus sign in JSON at position 1 (line 1 column 2)", "minus"},
+		{`1.`, "Unterminated fractional number in JSON at position 2 (line 1 column 3)", "fraction"},
+		{`1e+`, "Exponent part is missing a number in JSON at position 3 (line 1 column 4)", "exponent"},
+		{`"abc`, "Unterminated string in JSON at position 4 (line 1 column 5)", "unterminated string"},
+		{"\"a\tb\"", "Bad control character in string literal in JSON at position 2 (line 1 column 3)", "control char"},
+		{`"\x"`, "Bad escaped character in JSON at position 2 (line 1 column 3)", "bad escape"},
+		{`"\u12g4"`, "Bad Unicode escape in JSON at position 5 (line 1 column 6)", "bad unicode escape"},
+		{long, `Unexpected token 'x', ..."": [1, 2, x, 4], "mo"... is not valid JSON`, "context around token"},
+		{`[x, 1, 2, 3, 4, 5, 6, 7, 8]`, `Unexpected token 'x', "[x, 1, 2, 3"... is not valid JSON`, "context at start"},
+		{`[1, 2, 3, 4, 5, 6, 7, 8, x]`, `Unexpected token 'x', ..." 6, 7, 8, x]" is not valid JSON`, "context at end"},
+	}
+
+	for _, v := range tests {
+		_, err := Parse(v.incoming, nil)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("ParseError", t, v.incoming, msg, "SyntaxError: "+v.except, v.descr)
+	}
+
+	_, err := Parse("{\n  \"a\": tru\n}", nil)
+	se, _ := err.(*SyntaxError)
+	got := []int{se.Offset, se.Line, se.Column}
+	TestLog("ParseError", t, "", got, []int{12, 2, 11}, "offset, line & column")
+	var ase *array.SyntaxError
+	TestLog("ParseError", t, "", errors.As(err, &ase) && ase.Message == se.Message, true, "array.SyntaxError is unwrapped")
+}
@@ -0,0 +1,189 @@
+package json
+
+import (
+	"fmt"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/clone"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+)
+
+// Patch return doc with JSON Patch applied, like RFC 6902
+// 	patch is Array of operation objects, like parsed by Parse:
+// 	add, remove, replace, move, copy & test with "path" & "from" pointers;
+// 	array tokens are indexes & "-" is end of array, inserted values are copied
+// 	patch is applied to copy of doc, so doc isn't modified & nothing is applied on error
+// 	return PatchError for invalid operation or failed test & PointerError for unresolvable path
+func Patch(doc interface{}, patch *array.Array) (interface{}, error) {
+	r, err := clone.StructuredClone(doc)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range patch.Items {
+		if r, err = applyOperation(r, item.Data); err != nil {
+			if pe, ok := err.(*PatchError); ok {
+				pe.Message = fmt.Sprintf("operation %d: %s", i, pe.Message)
+			}
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func applyOperation(doc interface{}, op interface{}) (interface{}, error) {
+	if !jsondoc.IsObject(op) {
+		return nil, &PatchError{Message: "operation is not an object"}
+	}
+	name, _ := jsondoc.Member(op, "op")
+	path, err := operationPointer(op, "path")
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "add", "replace", "test":
+		v, ok := jsondoc.Member(op, "value")
+		if !ok {
+			return nil, &PatchError{Message: fmt.Sprintf("%s operation must have value", name)}
+		}
+		switch name {
+		case "add":
+			return add(doc, path, copyValue(v))
+		case "replace":
+			return replace(doc, path, copyValue(v))
+		}
+		cur, err := path.Get(doc)
+		if err != nil {
+			return nil, err
+		}
+		if !jsondoc.Equal(cur, v) {
+			return nil, &PatchError{Message: fmt.Sprintf("test failed at %q", path.String())}
+		}
+		return doc, nil
+	case "remove":
+		return remove(doc, path)
+	case "move", "copy":
+		from, err := operationPointer(op, "from")
+		if err != nil {
+			return nil, err
+		}
+		v, err := from.Get(doc)
+		if err != nil {
+			return nil, err
+		}
+		if name == "copy" {
+			return add(doc, path, copyValue(v))
+		}
+		if from.isPrefix(path) {
+			if len(from) == len(path) {
+				return doc, nil
+			}
+			return nil, &PatchError{Message: fmt.Sprintf("cannot move %q into its child %q", from.String(), path.String())}
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, path, v)
+	}
+	return nil, &PatchError{Message: fmt.Sprintf("invalid operation %v", name)}
+}
+
+// operationPointer return pointer of operation member
+func operationPointer(op interface{}, key string) (Pointer, error) {
+	v, _ := jsondoc.Member(op, key)
+	s, ok := v.(string)
+	if !ok {
+		return nil, &PatchError{Message: fmt.Sprintf("operation must have %s string", key)}
+	}
+	return ParsePointer(s)
+}
+
+// copyValue return deep copy of value inserted into document
+func copyValue(v interface{}) interface{} {
+	if c, err := clone.StructuredClone(v); err == nil {
+		return c
+	}
+	return v
+}
+
+// parent return parent node of path & last token of path
+func parent(doc interface{}, path Pointer) (interface{}, string, error) {
+	p, err := path[:len(path)-1].Get(doc)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, ok := p.(*array.Array); !ok && !jsondoc.IsObject(p) {
+		return nil, "", &PointerError{Message: fmt.Sprintf("Cannot resolve %q", path.String())}
+	}
+	return p, path[len(path)-1], nil
+}
+
+// add insert element into array or set member of object
+func add(doc interface{}, path Pointer, v interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return v, nil
+	}
+	p, token, err := parent(doc, path)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := p.(*array.Array)
+	if !ok {
+		jsondoc.SetMember(p, token, v)
+		return doc, nil
+	}
+
+	i, ok := parseIndex(token)
+	if token == "-" {
+		i, ok = len(arr.Items), true
+	}
+	if !ok || i > len(arr.Items) {
+		return nil, &PointerError{Message: fmt.Sprintf("Invalid array index %q", path.String())}
+	}
+	arr.Items = append(arr.Items, array.ArrayItem{})
+	copy(arr.Items[i+1:], arr.Items[i:])
+	arr.Items[i] = array.ArrayItem{Data: v}
+	return doc, nil
+}
+
+// remove delete element of array or member of object; removed root is nil
+func remove(doc interface{}, path Pointer) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	p, token, err := parent(doc, path)
+	if err != nil {
+		return nil, err
+	}
+	if arr, ok := p.(*array.Array); ok {
+		i, ok := parseIndex(token)
+		if !ok || i >= len(arr.Items) {
+			return nil, &PointerError{Message: fmt.Sprintf("Invalid array index %q", path.String())}
+		}
+		arr.Items = append(arr.Items[:i], arr.Items[i+1:]...)
+		return doc, nil
+	}
+	if !jsondoc.DeleteMember(p, token) {
+		return nil, &PointerError{Message: fmt.Sprintf("Cannot resolve %q", path.String())}
+	}
+	return doc, nil
+}
+
+// replace set existing element of array or member of object
+func replace(doc interface{}, path Pointer, v interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return v, nil
+	}
+	if _, err := path.Get(doc); err != nil {
+		return nil, err
+	}
+	p, token, _ := parent(doc, path)
+	if arr, ok := p.(*array.Array); ok {
+		i, _ := parseIndex(token)
+		arr.Items[i].Data = v
+		return doc, nil
+	}
+	jsondoc.SetMember(p, token, v)
+	return doc, nil
+}
+
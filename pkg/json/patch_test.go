@@ -0,0 +1,73 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestPatch(t *testing.T) {
+	tests := []struct {
+		doc    string
+		patch  string
+		except string
+		descr  string
+	}{
+		{`{"a": 1}`, `[{"op": "add", "path": "/b", "value": [1]}]`, `{"a":1,"b":[1]}`, "add member"},
+		{`{"a": [1, 2]}`, `[{"op": "add", "path": "/a/1", "value": 9}, {"op": "add", "path": "/a/-", "value": 3}]`, `{"a":[1,9,2,3]}`, "insert & append"},
+		{`{"a": 1, "b": 2}`, `[{"op": "remove", "path": "/a"}]`, `{"b":2}`, "remove member"},
+		{`[1, 2, 3]`, `[{"op": "remove", "path": "/1"}]`, `[1,3]`, "remove element"},
+		{`{"a": 1, "b": 2}`, `[{"op": "replace", "path": "/a", "value": null}]`, `{"a":null,"b":2}`, "replace keep position"},
+		{`{"a": {"x": 1}, "b": []}`, `[{"op": "move", "from": "/a/x", "path": "/b/0"}]`, `{"a":{},"b":[1]}`, "move"},
+		{`[1, 2, 3]`, `[{"op": "move", "from": "/0", "path": "/2"}]`, `[2,3,1]`, "move in array"},
+		{`{"a": {"x": [1]}}`, `[{"op": "copy", "from": "/a", "path": "/b"}, {"op": "add", "path": "/b/x/-", "value": 2}]`, `{"a":{"x":[1]},"b":{"x":[1,2]}}`, "copy is deep"},
+		{`{"a": [1, {"b": 2.0}]}`, `[{"op": "test", "path": "/a", "value": [1, {"b": 2}]}]`, `{"a":[1,{"b":2}]}`, "test"},
+		{`{"a": 1}`, `[{"op": "replace", "path": "", "value": [true]}]`, `[true]`, "replace root"},
+	}
+
+	for _, v := range tests {
+		doc := mustParse(t, v.doc)
+		before, _ := Stringify(doc, nil, nil)
+		got, err := Patch(doc, mustParse(t, v.patch).(*array.Array))
+		TestLog("Patch", t, v.patch, err, nil, v.descr)
+		s, _ := Stringify(got, nil, nil)
+		TestLog("Patch", t, v.patch, s, v.except, v.descr)
+		after, _ := Stringify(doc, nil, nil)
+		TestLog("Patch", t, v.patch, after, before, "doc isn't modified")
+	}
+}
+
+func TestPatchError(t *testing.T) {
+	tests := []struct {
+		doc    string
+		patch  string
+		except string
+		descr  string
+	}{
+		{`{"a": 1}`, `[{"op": "add", "path": "/b", "value": 2}, {"op": "test", "path": "/a", "value": "1"}]`, `PatchError: operation 1: test failed at "/a"`, "test failed"},
+		{`{}`, `[{"op": "add", "path": "/a/b", "value": 1}]`, `PointerError: Cannot resolve "/a"`, "missing parent"},
+		{`[1]`, `[{"op": "add", "path": "/2", "value": 1}]`, `PointerError: Invalid array index "/2"`, "index out of range"},
+		{`{}`, `[{"op": "remove", "path": "/a"}]`, `PointerError: Cannot resolve "/a"`, "remove missing"},
+		{`{}`, `[{"op": "replace", "path": "/a", "value": 1}]`, `PointerError: Cannot resolve "/a"`, "replace missing"},
+		{`{"a": {}}`, `[{"op": "move", "from": "/a", "path": "/a/b"}]`, `PatchError: operation 0: cannot move "/a" into its child "/a/b"`, "move into child"},
+		{`{}`, `[{"op": "add", "path": "/a"}]`, `PatchError: operation 0: add operation must have value`, "missing value"},
+		{`{}`, `[{"op": "merge", "path": "/a"}]`, `PatchError: operation 0: invalid operation merge`, "invalid op"},
+		{`{}`, `[{"op": "remove"}]`, `PatchError: operation 0: operation must have path string`, "missing path"},
+	}
+
+	for _, v := range tests {
+		doc := mustParse(t, v.doc)
+		got, err := Patch(doc, mustParse(t, v.patch).(*array.Array))
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("PatchError", t, v.patch, msg, v.except, v.descr)
+		TestLog("PatchError", t, v.patch, got, nil, v.descr)
+	}
+
+	doc := mustParse(t, `{"a": 1}`)
+	Patch(doc, mustParse(t, `[{"op": "add", "path": "/b", "value": 2}, {"op": "remove", "path": "/c"}]`).(*array.Array))
+	s, _ := Stringify(doc, nil, nil)
+	TestLog("PatchError", t, "", s, `{"a":1}`, "patch is atomic")
+}
@@ -0,0 +1,113 @@
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+)
+
+// documents are trees of *array.Array, objects & primitives, like parsed by Parse
+// objects are *jsmap.Map with string keys & map[string]interface{}
+
+// Pointer is JSON pointer, like RFC 6901
+// 	it is list of reference tokens without escapes; empty Pointer is whole document
+type Pointer []string
+
+// ParsePointer return Pointer of string, like "/items/3/name"
+// 	~1 is "/" & ~0 is "~"; return array.TypeError for invalid pointer
+func ParsePointer(s string) (Pointer, error) {
+	tokens, err := splitPointer(s)
+	return Pointer(tokens), err
+}
+
+// String return pointer with escaped tokens
+func (p Pointer) String() string {
+	var b strings.Builder
+	for _, t := range p {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// Get return value at pointer in doc
+// 	array tokens are indexes without leading zeros; "-" isn't value, like in RFC 6901
+// 	return PointerError if value isn't found
+func (p Pointer) Get(doc interface{}) (interface{}, error) {
+	cur := doc
+	for i, token := range p {
+		next, ok := child(cur, token)
+		if !ok {
+			return nil, &PointerError{Message: fmt.Sprintf("Cannot resolve %q", p[:i+1].String())}
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// append return pointer to child with token
+func (p Pointer) append(token string) Pointer {
+	return append(p[:len(p):len(p)], token)
+}
+
+// isPrefix check is p pointer to q or its ancestor
+func (p Pointer) isPrefix(q Pointer) bool {
+	if len(p) > len(q) {
+		return false
+	}
+	for i := range p {
+		if p[i] != q[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// child return element or member of document node
+func child(node interface{}, token string) (interface{}, bool) {
+	if arr, ok := node.(*array.Array); ok {
+		i, ok := parseIndex(token)
+		if !ok || i >= len(arr.Items) {
+			return nil, false
+		}
+		return arr.Items[i].Data, true
+	}
+	return jsondoc.Member(node, token)
+}
+
+// parseIndex return array index of token, like RFC 6901
+func parseIndex(token string) (int, bool) {
+	if token == "" || len(token) > 1 && token[0] == '0' {
+		return 0, false
+	}
+	for i := 0; i < len(token); i++ {
+		if token[i] < '0' || token[i] > '9' {
+			return 0, false
+		}
+	}
+	i, err := strconv.Atoi(token)
+	return i, err == nil
+}
+
+// splitPointer return reference tokens of JSON pointer, like RFC 6901
+func splitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, &array.TypeError{Message: fmt.Sprintf("Invalid JSON pointer %q", pointer)}
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j+1 == len(t) || t[j+1] != '0' && t[j+1] != '1') {
+				return nil, &array.TypeError{Message: fmt.Sprintf("Invalid JSON pointer %q", pointer)}
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
@@ -0,0 +1,70 @@
+package json
+
+import (
+	"testing"
+)
+
+// mustParse return parsed JSON text of test document
+func mustParse(t *testing.T, text string) interface{} {
+	v, err := Parse(text, nil)
+	if err != nil {
+		t.Fatalf("Parse(%v): %v", text, err)
+	}
+	return v
+}
+
+func TestPointer(t *testing.T) {
+	doc := mustParse(t, `{"foo": ["bar", "baz"], "": 0, "a/b": 1, "m~n": 8, " ": 7, "items": [{"name": "x"}]}`)
+	tests := []struct {
+		incoming string
+		except   interface{}
+		descr    string
+	}{
+		{"", doc, "whole document"},
+		{"/foo", mustParse(t, `["bar", "baz"]`), "member"},
+		{"/foo/0", "bar", "array element"},
+		{"/", float64(0), "empty key"},
+		{"/a~1b", float64(1), "escaped slash"},
+		{"/m~0n", float64(8), "escaped tilde"},
+		{"/ ", float64(7), "space key"},
+		{"/items/0/name", "x", "nested"},
+	}
+
+	for _, v := range tests {
+		p, err := ParsePointer(v.incoming)
+		TestLog("ParsePointer", t, v.incoming, err, nil, v.descr)
+		TestLog("Pointer.String", t, v.incoming, p.String(), v.incoming, v.descr)
+		got, err := p.Get(doc)
+		TestLog("Pointer.Get", t, v.incoming, err, nil, v.descr)
+		TestLog("Pointer.Get", t, v.incoming, got, v.except, v.descr)
+	}
+}
+
+func TestPointerError(t *testing.T) {
+	doc := mustParse(t, `{"foo": ["bar"], "n": 1}`)
+	tests := []struct {
+		incoming string
+		except   string
+		descr    string
+	}{
+		{"foo", `TypeError: Invalid JSON pointer "foo"`, "no slash"},
+		{"/a~2", `TypeError: Invalid JSON pointer "/a~2"`, "bad escape"},
+		{"/bar", `PointerError: Cannot resolve "/bar"`, "missing member"},
+		{"/foo/1", `PointerError: Cannot resolve "/foo/1"`, "index out of range"},
+		{"/foo/01", `PointerError: Cannot resolve "/foo/01"`, "leading zero"},
+		{"/foo/-", `PointerError: Cannot resolve "/foo/-"`, "end of array"},
+		{"/n/x", `PointerError: Cannot resolve "/n/x"`, "member of primitive"},
+	}
+
+	for _, v := range tests {
+		p, err := ParsePointer(v.incoming)
+		if err == nil {
+			_, err = p.Get(doc)
+		}
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("PointerError", t, v.incoming, msg, v.except, v.descr)
+	}
+}
@@ -0,0 +1,70 @@
+package json
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+const hex = "0123456789abcdef"
+
+// quote write s as JSON string, like js QuoteJSONString
+// 	control chars & lone surrogates are escaped as \u00xx, other chars are written as is
+func quote(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	if plainString(s) {
+		buf.WriteString(s)
+		buf.WriteByte('"')
+		return
+	}
+
+	u := jsstring.New(s)
+	for i := 0; i < len(u); i++ {
+		c := u[i]
+		switch {
+		case c == '"':
+			buf.WriteString(`\"`)
+		case c == '\\':
+			buf.WriteString(`\\`)
+		case c == '\b':
+			buf.WriteString(`\b`)
+		case c == '\f':
+			buf.WriteString(`\f`)
+		case c == '\n':
+			buf.WriteString(`\n`)
+		case c == '\r':
+			buf.WriteString(`\r`)
+		case c == '\t':
+			buf.WriteString(`\t`)
+		case c < 0x20:
+			escapeUnit(buf, c)
+		case c >= 0xd800 && c <= 0xdbff && i+1 < len(u) && u[i+1] >= 0xdc00 && u[i+1] <= 0xdfff:
+			buf.WriteRune((rune(c)-0xd800)<<10 + rune(u[i+1]) - 0xdc00 + 0x10000)
+			i++
+		case c >= 0xd800 && c <= 0xdfff:
+			escapeUnit(buf, c)
+		default:
+			buf.WriteRune(rune(c))
+		}
+	}
+	buf.WriteByte('"')
+}
+
+func escapeUnit(buf *bytes.Buffer, c uint16) {
+	buf.WriteString(`\u`)
+	buf.WriteByte(hex[c>>12])
+	buf.WriteByte(hex[c>>8&0xf])
+	buf.WriteByte(hex[c>>4&0xf])
+	buf.WriteByte(hex[c&0xf])
+}
+
+// plainString check is s valid UTF-8 without chars which must be escaped
+func plainString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c == '"' || c == '\\' {
+			return false
+		}
+	}
+	return utf8.ValidString(s)
+}
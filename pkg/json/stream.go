@@ -0,0 +1,377 @@
+package json
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// stream states of Decoder
+const (
+	streamStart = iota
+	streamFirst
+	streamNext
+	streamDone
+)
+
+// Decoder read elements of JSON array from stream one by one
+// 	only current element is kept in memory, elements are like in Parse
+// 	positions of errors are in UTF-16 code units like in Parse, Offset is in bytes
+type Decoder struct {
+	r       *bufio.Reader
+	pointer string
+	state   int
+	err     error
+	readErr error
+
+	offset int64
+	// units, line & lineStart are for error positions
+	units     int
+	line      int
+	lineStart int
+	cr        bool
+}
+
+// NewDecoder return Decoder of array in r at JSON pointer, like "/data/items"
+// 	pointer "" is top-level array; values before array are skipped without validation
+// 	Next return array.TypeError if pointer is invalid or value at pointer is not an array
+func NewDecoder(r io.Reader, pointer string) *Decoder {
+	return &Decoder{r: bufio.NewReader(r), pointer: pointer, line: 1}
+}
+
+// ResumeDecoder return Decoder which continue reading array after element
+// 	r is positioned at offset returned by Offset after Next or Batch of previous Decoder;
+// 	positions of errors are counted from resume point
+func ResumeDecoder(r io.Reader, offset int64) *Decoder {
+	d := NewDecoder(r, "")
+	d.offset = offset
+	d.state = streamNext
+	return d
+}
+
+// Offset return number of bytes read till end of last returned element
+// 	after last element it is offset after closing bracket of array
+func (d *Decoder) Offset() int64 {
+	return d.offset
+}
+
+// Next return next element of array; return io.EOF after last element
+// 	errors are returned again on next calls
+func (d *Decoder) Next() (interface{}, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	v, err := d.next()
+	if d.readErr != nil && d.readErr != io.EOF {
+		err = d.readErr
+	}
+	if err != nil {
+		d.err = err
+		return nil, err
+	}
+	return v, nil
+}
+
+// Batch return Array of up to n next elements; n less than 1 is 1
+// 	return io.EOF after last element; on error read elements are returned with error
+func (d *Decoder) Batch(n int) (*array.Array, error) {
+	if n < 1 {
+		n = 1
+	}
+	arr := array.NewArray()
+	for len(arr.Items) < n {
+		v, err := d.Next()
+		if err == io.EOF && len(arr.Items) > 0 {
+			break
+		}
+		if err != nil {
+			if len(arr.Items) == 0 {
+				return nil, err
+			}
+			return arr, err
+		}
+		arr.Items = append(arr.Items, array.ArrayItem{Data: v})
+	}
+	return arr, nil
+}
+
+func (d *Decoder) next() (interface{}, error) {
+	switch d.state {
+	case streamDone:
+		return nil, io.EOF
+	case streamStart:
+		if err := d.open(); err != nil {
+			return nil, err
+		}
+	}
+
+	d.skipSpace()
+	c, _ := d.peek()
+	if c == ']' {
+		d.read()
+		d.state = streamDone
+		return nil, io.EOF
+	}
+	if d.state == streamNext {
+		if c != ',' {
+			return nil, d.fail("Expected ',' or ']' after array element")
+		}
+		d.read()
+		d.skipSpace()
+	}
+
+	origin := d.origin()
+	text, _ := d.scan(true)
+	v, err := d.parse(text, origin, "Expected ',' or ']' after array element")
+	if err != nil {
+		return nil, err
+	}
+	d.state = streamNext
+	return v, nil
+}
+
+// open move to array at pointer & read its opening bracket
+func (d *Decoder) open() error {
+	path, err := splitPointer(d.pointer)
+	if err != nil {
+		return err
+	}
+	for _, key := range path {
+		d.skipSpace()
+		found := false
+		switch c, _ := d.peek(); c {
+		case '{':
+			d.read()
+			found, err = d.findKey(key)
+		case '[':
+			d.read()
+			found, err = d.findIndex(key)
+		}
+		if err != nil {
+			return err
+		}
+		if !found {
+			return d.notArray()
+		}
+	}
+
+	d.skipSpace()
+	c, ok := d.peek()
+	if !ok {
+		return d.fail("")
+	}
+	if c != '[' {
+		return d.notArray()
+	}
+	d.read()
+	d.state = streamFirst
+	return nil
+}
+
+func (d *Decoder) notArray() error {
+	return &array.TypeError{Message: fmt.Sprintf("value at pointer %q is not an array", d.pointer)}
+}
+
+// findKey skip properties of object till property with key
+func (d *Decoder) findKey(key string) (bool, error) {
+	d.skipSpace()
+	if c, _ := d.peek(); c == '}' {
+		return false, nil
+	}
+	for first := true; ; first = false {
+		if c, _ := d.peek(); c != '"' {
+			if first {
+				return false, d.fail("Expected property name or '}'")
+			}
+			return false, d.fail("Expected double-quoted property name")
+		}
+		origin := d.origin()
+		text, _ := d.scan(true)
+		k, err := d.parse(text, origin, "")
+		if err != nil {
+			return false, err
+		}
+		d.skipSpace()
+		if c, _ := d.peek(); c != ':' {
+			return false, d.fail("Expected ':' after property name")
+		}
+		d.read()
+		d.skipSpace()
+		if k == key {
+			return true, nil
+		}
+		if err := d.skip(); err != nil {
+			return false, err
+		}
+
+		d.skipSpace()
+		switch c, _ := d.peek(); c {
+		case ',':
+			d.read()
+			d.skipSpace()
+		case '}':
+			return false, nil
+		default:
+			return false, d.fail("Expected ',' or '}' after property value")
+		}
+	}
+}
+
+// findIndex skip elements of array till element at index key
+func (d *Decoder) findIndex(key string) (bool, error) {
+	index, ok := parseIndex(key)
+	if !ok {
+		return false, nil
+	}
+	for i := 0; ; i++ {
+		d.skipSpace()
+		if c, _ := d.peek(); c == ']' {
+			return false, nil
+		}
+		if i == index {
+			return true, nil
+		}
+		if err := d.skip(); err != nil {
+			return false, err
+		}
+
+		d.skipSpace()
+		switch c, _ := d.peek(); c {
+		case ',':
+			d.read()
+		case ']':
+			return false, nil
+		default:
+			return false, d.fail("Expected ',' or ']' after array element")
+		}
+	}
+}
+
+// skip read value without keeping it
+func (d *Decoder) skip() error {
+	if _, complete := d.scan(false); !complete {
+		return d.fail("")
+	}
+	return nil
+}
+
+// scan read value & return its text if keep is true
+// 	value is string, object, array or other chars till delimiter; it isn't validated
+// 	complete is false if stream is ended inside value
+func (d *Decoder) scan(keep bool) (text []byte, complete bool) {
+	depth := 0
+	inString, escaped := false, false
+	for n := 0; ; n++ {
+		c, ok := d.peek()
+		if !ok {
+			return text, n > 0 && depth == 0 && !inString
+		}
+		if n > 0 && depth == 0 && !inString && isDelimiter(c) {
+			return text, true
+		}
+		d.read()
+		if keep {
+			text = append(text, c)
+		}
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			if c == '\\' {
+				escaped = true
+			} else if c == '"' {
+				inString = false
+				if depth == 0 {
+					return text, true
+				}
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			if depth--; depth <= 0 {
+				return text, true
+			}
+		}
+	}
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', ',', ']', '}', ':', '"', '[', '{':
+		return true
+	}
+	return false
+}
+
+// parse return value of text read at origin
+// 	trailing chars are reported with after message, like expected delimiter
+func (d *Decoder) parse(text []byte, origin position, after string) (interface{}, error) {
+	p := &parser{u: jsstring.New(string(text)), origin: origin}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.u) {
+		return nil, p.fail(after)
+	}
+	return v, nil
+}
+
+// fail return SyntaxError of message at current position
+// 	empty message is unexpected end of input
+func (d *Decoder) fail(message string) error {
+	p := &parser{origin: d.origin()}
+	if message == "" {
+		return p.unexpected()
+	}
+	return p.fail(message)
+}
+
+func (d *Decoder) origin() position {
+	return position{offset: d.units, line: d.line - 1, column: d.units - d.lineStart}
+}
+
+func (d *Decoder) peek() (byte, bool) {
+	b, err := d.r.Peek(1)
+	if err != nil {
+		d.readErr = err
+		return 0, false
+	}
+	return b[0], true
+}
+
+// read consume peeked byte
+func (d *Decoder) read() {
+	c, _ := d.r.ReadByte()
+	d.offset++
+	if c&0xc0 != 0x80 {
+		d.units++
+	}
+	if c >= 0xf0 {
+		// 4-byte chars are surrogate pairs
+		d.units++
+	}
+	if c == '\n' && !d.cr || c == '\r' {
+		d.line++
+	}
+	if c == '\n' || c == '\r' {
+		d.lineStart = d.units
+	}
+	d.cr = c == '\r'
+}
+
+func (d *Decoder) skipSpace() {
+	for {
+		switch c, _ := d.peek(); c {
+		case ' ', '\t', '\n', '\r':
+			d.read()
+		default:
+			return
+		}
+	}
+}
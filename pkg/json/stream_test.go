@@ -0,0 +1,112 @@
+package json
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// readAll return all elements of decoder & error which stopped it
+func readAll(d *Decoder) ([]interface{}, error) {
+	r := []interface{}{}
+	for {
+		v, err := d.Next()
+		if err == io.EOF {
+			return r, nil
+		}
+		if err != nil {
+			return r, err
+		}
+		r = append(r, v)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	tests := []struct {
+		incoming string
+		pointer  string
+		except   []interface{}
+		descr    string
+	}{
+		{`[]`, "", []interface{}{}, "empty array"},
+		{` [1, "a", {"b": [true]}, null] `, "", []interface{}{float64(1), "a", ordered("b", array.MakeArray(true)), nil}, "top-level array"},
+		{`{"meta": {"x": [1, "]"]}, "data": {"skip": "}", "items": [[1], "é"]}}`, "/data/items", []interface{}{array.MakeArray(float64(1)), "é"}, "nested array"},
+		{`[0, [1, 2], [3]]`, "/1", []interface{}{float64(1), float64(2)}, "array index"},
+		{`{"a/b": {"~": [1]}}`, "/a~1b/~0", []interface{}{float64(1)}, "escaped pointer"},
+		{`{"a\"b": [1], "ab": [2]}`, "/ab", []interface{}{float64(2)}, "escaped key is skipped"},
+	}
+
+	for _, v := range tests {
+		got, err := readAll(NewDecoder(strings.NewReader(v.incoming), v.pointer))
+		TestLog("Decoder", t, v.incoming, err, nil, v.descr)
+		TestLog("Decoder", t, v.incoming, got, v.except, v.descr)
+	}
+}
+
+func TestDecoderBatch(t *testing.T) {
+	d := NewDecoder(strings.NewReader(`[1, 2, 3, 4, 5]`), "")
+	var got []*array.Array
+	for {
+		arr, err := d.Batch(2)
+		if err != nil {
+			TestLog("DecoderBatch", t, "", err, io.EOF, "end with EOF")
+			break
+		}
+		got = append(got, arr)
+	}
+	except := []*array.Array{
+		array.MakeArray(float64(1), float64(2)),
+		array.MakeArray(float64(3), float64(4)),
+		array.MakeArray(float64(5)),
+	}
+	TestLog("DecoderBatch", t, "", got, except, "batches of 2")
+}
+
+func TestDecoderOffset(t *testing.T) {
+	text := `{"data": [{"id": 1}, {"id": 2}, {"id": 3}]}`
+	d := NewDecoder(strings.NewReader(text), "/data")
+	d.Next()
+	d.Next()
+	offset := d.Offset()
+	TestLog("DecoderOffset", t, text, text[:offset], `{"data": [{"id": 1}, {"id": 2}`, "offset after element")
+
+	rest, err := readAll(ResumeDecoder(strings.NewReader(text[offset:]), offset))
+	TestLog("DecoderOffset", t, text, err, nil, "resume")
+	TestLog("DecoderOffset", t, text, rest, []interface{}{ordered("id", float64(3))}, "resume after element")
+
+	readAll(d)
+	TestLog("DecoderOffset", t, text, d.Offset(), int64(len(text)-1), "offset after array")
+}
+
+func TestDecoderError(t *testing.T) {
+	tests := []struct {
+		incoming string
+		pointer  string
+		except   string
+		descr    string
+	}{
+		{`[1, 2`, "", "SyntaxError: Expected ',' or ']' after array element in JSON at position 5 (line 1 column 6)", "truncated array"},
+		{`[1, {"a": `, "", "SyntaxError: Unexpected end of JSON input", "truncated element"},
+		{"[1,\n 2x]", "", "SyntaxError: Expected ',' or ']' after array element in JSON at position 6 (line 2 column 3)", "bad element"},
+		{"[1,\n [tx]]", "", `SyntaxError: Unexpected token 'x', "[tx]" is not valid JSON`, "unexpected token in element"},
+		{`[1,]`, "", `SyntaxError: Unexpected token ']', "]" is not valid JSON`, "trailing comma"},
+		{`{"data": 1}`, "/data", `TypeError: value at pointer "/data" is not an array`, "not an array"},
+		{`{"data": []}`, "/items", `TypeError: value at pointer "/items" is not an array`, "missing property"},
+		{`[]`, "data", `TypeError: Invalid JSON pointer "data"`, "invalid pointer"},
+		{`{"a": [1, [2`, "/b", "SyntaxError: Unexpected end of JSON input", "truncated skipped value"},
+	}
+
+	for _, v := range tests {
+		d := NewDecoder(strings.NewReader(v.incoming), v.pointer)
+		_, err := readAll(d)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("DecoderError", t, v.incoming, msg, v.except, v.descr)
+		_, again := d.Next()
+		TestLog("DecoderError", t, v.incoming, again, err, "error is kept")
+	}
+}
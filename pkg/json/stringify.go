@@ -0,0 +1,442 @@
+package json
+
+import (
+	"bytes"
+	stdjson "encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/hash"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+	"github.com/miron-developer/golang-js-utils/pkg/object"
+	"github.com/miron-developer/golang-js-utils/pkg/set"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+// ToJSONer is value with own JSON representation, like object with js toJSON method
+// 	key is property name of value or index in array
+type ToJSONer interface {
+	ToJSON(key string) interface{}
+}
+
+// Replacer is replacer function of Stringify, like js JSON.stringify replacer
+// 	key is "" for root value; returning value.Undefined omit property
+type Replacer func(key string, value interface{}) interface{}
+
+// Stringify return JSON of v, like js JSON.stringify
+// 	replacer is nil, Replacer or *array.Array of allowed property names
+// 	space is number of indent spaces up to 10 or string with indent up to 10 chars
+// 	return empty string for undefined, func & symbol, like js return undefined
+// 	return array.TypeError for BigInt & cyclic structure
+//
+// 	values are converted like in js:
+// 	ToJSONer & time.Time are converted first, time.Time is ISO string like js Date;
+// 	nil is null, numbers are formatted like js numbers, NaN & Infinity are null;
+// 	*array.Array, slices & go arrays are arrays; undefined elements are null;
+// 	*jsmap.Map, maps & structs are objects; undefined properties are omitted;
+// 	map keys are ordered like js object keys, jsmap keys are in insertion order;
+// 	struct fields are named by json tags; *set.Set is {} like in js;
+// 	other encoding/json Marshaler is written as its JSON
+func Stringify(v interface{}, replacer interface{}, space interface{}) (string, error) {
+	return stringify(v, replacer, space, false)
+}
+
+func stringify(v interface{}, replacer interface{}, space interface{}, json5 bool) (string, error) {
+	e := &encoder{gap: gap(space), json5: json5, stack: map[interface{}]bool{}}
+	switch r := replacer.(type) {
+	case Replacer:
+		e.replacer = r
+	case func(key string, value interface{}) interface{}:
+		e.replacer = r
+	case *array.Array:
+		e.allow = allowList(r)
+	}
+
+	ok, err := e.property("", v)
+	if err != nil || !ok {
+		return "", err
+	}
+	return e.buf.String(), nil
+}
+
+// gap return indent of space argument
+func gap(space interface{}) string {
+	if v, ok := space.(value.Value); ok {
+		space = v.Interface()
+	}
+	if s, ok := space.(string); ok {
+		u := jsstring.New(s)
+		if len(u) > 10 {
+			u = u[:10]
+		}
+		return u.String()
+	}
+	if f, ok := conv.Number(space); ok && f >= 1 {
+		return strings.Repeat(" ", int(math.Min(10, f)))
+	}
+	return ""
+}
+
+// allowList return property names of replacer array without duplicates
+// 	strings & numbers are names, other elements are ignored
+func allowList(arr *array.Array) []string {
+	r := []string{}
+	seen := map[string]bool{}
+	for _, item := range arr.Items {
+		d := item.Data
+		if v, ok := d.(value.Value); ok {
+			d = v.Interface()
+		}
+		var name string
+		if s, ok := d.(string); ok {
+			name = s
+		} else if f, ok := conv.Number(d); ok {
+			name = conv.NumberToString(f)
+		} else {
+			continue
+		}
+		if !seen[name] {
+			seen[name] = true
+			r = append(r, name)
+		}
+	}
+	return r
+}
+
+type encoder struct {
+	buf      bytes.Buffer
+	replacer Replacer
+	allow    []string
+	gap      string
+	indent   string
+	json5    bool
+	// stack are objects being serialized, used for cycle detection
+	stack map[interface{}]bool
+}
+
+// undefined is marker of value which is omitted from object
+type undefined struct{}
+
+// prepare return value of property after toJSON & replacer, like js SerializeJSONProperty steps 1-3
+func (e *encoder) prepare(key string, v interface{}) interface{} {
+	v = unwrap(v)
+	switch t := v.(type) {
+	case ToJSONer:
+		v = unwrap(t.ToJSON(key))
+	case time.Time:
+		v = isoString(t)
+	case *time.Time:
+		if t != nil {
+			v = isoString(*t)
+		}
+	}
+	if e.replacer != nil {
+		v = unwrap(e.replacer(key, v))
+	}
+	return v
+}
+
+// unwrap return go value of value.Value; undefined & symbol become undefined marker
+func unwrap(v interface{}) interface{} {
+	val, ok := v.(value.Value)
+	if !ok {
+		return v
+	}
+	switch val.Kind() {
+	case value.KindUndefined, value.KindSymbol:
+		return undefined{}
+	}
+	return val.Interface()
+}
+
+// isoString return t in js Date toISOString format
+func isoString(t time.Time) string {
+	t = t.UTC()
+	y := t.Year()
+	var year string
+	switch {
+	case y < 0:
+		year = "-" + pad(-y, 6)
+	case y > 9999:
+		year = "+" + pad(y, 6)
+	default:
+		year = pad(y, 4)
+	}
+	return year + t.Format("-01-02T15:04:05.000Z")
+}
+
+func pad(n, width int) string {
+	s := strconv.Itoa(n)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
+
+// property write JSON of property value; written is false if value is undefined
+func (e *encoder) property(key string, v interface{}) (written bool, err error) {
+	return e.write(e.prepare(key, v))
+}
+
+// write write JSON of prepared value, like js SerializeJSONProperty steps 4-12
+func (e *encoder) write(v interface{}) (written bool, err error) {
+	switch t := v.(type) {
+	case undefined:
+		return false, nil
+	case nil:
+		e.buf.WriteString("null")
+		return true, nil
+	case bool:
+		e.buf.WriteString(strconv.FormatBool(t))
+		return true, nil
+	case string:
+		e.quote(t)
+		return true, nil
+	case jsstring.JSString:
+		e.quote(t.String())
+		return true, nil
+	case *big.Int:
+		return false, &array.TypeError{Message: "Do not know how to serialize a BigInt"}
+	case *value.Symbol:
+		return false, nil
+	case *array.Array:
+		if t == nil {
+			e.buf.WriteString("null")
+			return true, nil
+		}
+		return true, e.enter(t, func() error { return e.array(len(t.Items), func(i int) interface{} { return t.Items[i].Data }) })
+	case *jsmap.Map:
+		if t == nil {
+			e.buf.WriteString("null")
+			return true, nil
+		}
+		return true, e.enter(t, func() error { return e.object(mapEntries(t)) })
+	case *set.Set:
+		if t == nil {
+			e.buf.WriteString("null")
+			return true, nil
+		}
+		return true, e.object(nil)
+	}
+	if f, ok := conv.Number(v); ok {
+		e.number(f)
+		return true, nil
+	}
+	if m, ok := v.(stdjson.Marshaler); ok {
+		return true, e.raw(m)
+	}
+	return e.reflect(v)
+}
+
+func (e *encoder) quote(s string) {
+	if e.json5 {
+		quoteJSON5(&e.buf, s)
+		return
+	}
+	quote(&e.buf, s)
+}
+
+func (e *encoder) number(f float64) {
+	if (math.IsNaN(f) || math.IsInf(f, 0)) && !e.json5 {
+		e.buf.WriteString("null")
+		return
+	}
+	e.buf.WriteString(conv.NumberToString(f))
+}
+
+func (e *encoder) reflect(v interface{}) (bool, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			e.buf.WriteString("null")
+			return true, nil
+		}
+		written := false
+		err := e.enter(v, func() (err error) {
+			written, err = e.write(rv.Elem().Interface())
+			return err
+		})
+		return written, err
+	case reflect.Bool:
+		e.buf.WriteString(strconv.FormatBool(rv.Bool()))
+		return true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.number(float64(rv.Int()))
+		return true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.number(float64(rv.Uint()))
+		return true, nil
+	case reflect.Float32, reflect.Float64:
+		e.number(rv.Float())
+		return true, nil
+	case reflect.String:
+		e.quote(rv.String())
+		return true, nil
+	case reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			e.buf.WriteString("null")
+			return true, nil
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Map, reflect.Struct:
+		return true, e.enter(v, func() error {
+			entries := object.Entries(v)
+			r := make([]entry, len(entries.Items))
+			for i, item := range entries.Items {
+				pair := item.Data.(*array.Array)
+				r[i] = entry{key: pair.Items[0].Data.(string), value: pair.Items[1].Data}
+			}
+			return e.object(r)
+		})
+	case reflect.Slice, reflect.Array:
+		return true, e.enter(v, func() error {
+			return e.array(rv.Len(), func(i int) interface{} { return rv.Index(i).Interface() })
+		})
+	}
+	// funcs, chans, complex numbers & unsafe pointers are undefined
+	return false, nil
+}
+
+// enter call fn with v in stack; return TypeError if v is already in stack
+func (e *encoder) enter(v interface{}, fn func() error) error {
+	k, ok := hash.Key(v)
+	if !ok {
+		return fn()
+	}
+	if e.stack[k] {
+		return &array.TypeError{Message: "Converting circular structure to JSON"}
+	}
+	e.stack[k] = true
+	defer delete(e.stack, k)
+	return fn()
+}
+
+type entry struct {
+	key   string
+	value interface{}
+}
+
+func mapEntries(m *jsmap.Map) []entry {
+	r := make([]entry, 0, m.Size())
+	m.ForEach(func(v, k interface{}, _ *jsmap.Map) {
+		r = append(r, entry{key: value.Of(k).String(), value: v})
+	})
+	return r
+}
+
+// object write JSON object of entries, like js SerializeJSONObject
+// 	with allow list only allowed properties are written in order of list
+func (e *encoder) object(entries []entry) error {
+	if e.allow != nil {
+		byKey := make(map[string]interface{}, len(entries))
+		for _, en := range entries {
+			byKey[en.key] = en.value
+		}
+		allowed := make([]entry, 0, len(e.allow))
+		for _, k := range e.allow {
+			if v, ok := byKey[k]; ok {
+				allowed = append(allowed, entry{key: k, value: v})
+			}
+		}
+		entries = allowed
+	}
+
+	stepback := e.indent
+	e.indent += e.gap
+	e.buf.WriteByte('{')
+	n := 0
+	for _, en := range entries {
+		mark := e.buf.Len()
+		if n > 0 {
+			e.buf.WriteByte(',')
+		}
+		e.newline()
+		if e.json5 {
+			keyJSON5(&e.buf, en.key)
+		} else {
+			quote(&e.buf, en.key)
+		}
+		e.buf.WriteByte(':')
+		if e.gap != "" {
+			e.buf.WriteByte(' ')
+		}
+		written, err := e.property(en.key, en.value)
+		if err != nil {
+			return err
+		}
+		if !written {
+			e.buf.Truncate(mark)
+			continue
+		}
+		n++
+	}
+	e.indent = stepback
+	e.close(n)
+	e.buf.WriteByte('}')
+	return nil
+}
+
+// array write JSON array of n elements, like js SerializeJSONArray
+func (e *encoder) array(n int, elem func(i int) interface{}) error {
+	stepback := e.indent
+	e.indent += e.gap
+	e.buf.WriteByte('[')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		e.newline()
+		written, err := e.property(strconv.Itoa(i), elem(i))
+		if err != nil {
+			return err
+		}
+		if !written {
+			e.buf.WriteString("null")
+		}
+	}
+	e.indent = stepback
+	e.close(n)
+	e.buf.WriteByte(']')
+	return nil
+}
+
+// close write end of object or array with n elements before closing bracket
+// 	indented JSON5 has trailing comma, like JSON5.stringify
+func (e *encoder) close(n int) {
+	if n == 0 {
+		return
+	}
+	if e.json5 && e.gap != "" {
+		e.buf.WriteByte(',')
+	}
+	e.newline()
+}
+
+func (e *encoder) newline() {
+	if e.gap != "" {
+		e.buf.WriteByte('\n')
+		e.buf.WriteString(e.indent)
+	}
+}
+
+// raw write JSON of encoding/json Marshaler formatted with indent
+func (e *encoder) raw(m stdjson.Marshaler) error {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if e.gap == "" {
+		return stdjson.Compact(&e.buf, b)
+	}
+	return stdjson.Indent(&e.buf, b, e.indent, e.gap)
+}
@@ -0,0 +1,192 @@
+package json
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/set"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+type point struct {
+	X    int     `json:"x"`
+	Y    float64 `json:"y"`
+	Skip string  `json:"-"`
+	Note *string
+	priv int
+}
+
+type celsius float64
+
+type money struct {
+	cents int
+}
+
+func (m money) ToJSON(key string) interface{} {
+	return key + ":" + big.NewInt(int64(m.cents)).String()
+}
+
+type raw struct{}
+
+func (raw) MarshalJSON() ([]byte, error) {
+	return []byte(`{ "a" : [1, 2] }`), nil
+}
+
+func ordered(kv ...interface{}) *jsmap.Map {
+	m := jsmap.New()
+	for i := 0; i < len(kv); i += 2 {
+		m.Set(kv[i], kv[i+1])
+	}
+	return m
+}
+
+func TestStringify(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        string
+		description string
+	}{
+		{incoming: nil, want: "null", description: "nil is null"},
+		{incoming: true, want: "true", description: "bool"},
+		{incoming: -0.0, want: "0", description: "number"},
+		{incoming: math.Copysign(0, -1), want: "0", description: "-0 is 0"},
+		{incoming: 1e21, want: "1e+21", description: "exponent like js"},
+		{incoming: 0.000001, want: "0.000001", description: "small number like js"},
+		{incoming: math.NaN(), want: "null", description: "NaN is null"},
+		{incoming: math.Inf(-1), want: "null", description: "Infinity is null"},
+		{incoming: celsius(36.6), want: "36.6", description: "named number type"},
+		{incoming: "<a href=\"x\">&</a>", want: `"<a href=\"x\">&</a>"`, description: "no html escaping"},
+		{incoming: "  \u007fé\U0001f600", want: "\"  \u007fé\U0001f600\"", description: "unicode as is"},
+		{incoming: "\b\f\n\r\t\u0000\u001f\\", want: `"\b\f\n\r\t\u0000\u001f\\"`, description: "control chars"},
+		{incoming: "a\xed\xa0\x80b", want: `"a\ud800b"`, description: "lone surrogate is escaped"},
+		{incoming: array.MakeArray(1, "a", nil, value.Undefined, func() {}, value.NewSymbol("s")), want: `[1,"a",null,null,null,null]`, description: "undefined elements are null"},
+		{incoming: ordered("b", 1, "a", value.Undefined, "c", func() {}, 1, true), want: `{"b":1,"1":true}`, description: "ordered map keeps insertion order"},
+		{incoming: map[string]interface{}{"b": 1, "a": 2, "10": 3, "2": 4}, want: `{"2":4,"10":3,"a":2,"b":1}`, description: "map keys in js order"},
+		{incoming: point{X: 1, Y: 2.5, Skip: "s"}, want: `{"x":1,"y":2.5,"Note":null}`, description: "struct by json tags"},
+		{incoming: &point{X: 1}, want: `{"x":1,"y":0,"Note":null}`, description: "pointer to struct"},
+		{incoming: []int(nil), want: "null", description: "nil slice"},
+		{incoming: [2]bool{true}, want: "[true,false]", description: "go array"},
+		{incoming: set.New(1, 2), want: "{}", description: "Set is empty object like in js"},
+		{incoming: array.MakeArray(), want: "[]", description: "empty array"},
+		{incoming: map[string]int{}, want: "{}", description: "empty object"},
+		{incoming: time.Date(2020, 1, 2, 3, 4, 5, 6e6, time.UTC), want: `"2020-01-02T03:04:05.006Z"`, description: "time like js Date"},
+		{incoming: time.Date(12345, 1, 1, 0, 0, 0, 0, time.FixedZone("x", 3600)), want: `"+012344-12-31T23:00:00.000Z"`, description: "extended year in UTC"},
+		{incoming: map[string]interface{}{"m": money{cents: 150}}, want: `{"m":"m:150"}`, description: "ToJSON with key"},
+		{incoming: array.MakeArray(raw{}), want: `[{"a":[1,2]}]`, description: "Marshaler is compacted"},
+		{incoming: value.NewArray(1, value.Null, "x"), want: `[1,null,"x"]`, description: "Values"},
+		{incoming: value.Object(map[string]int{"a": 1}), want: `{"a":1}`, description: "Object Value"},
+	}
+
+	for _, tt := range tests {
+		got, err := Stringify(tt.incoming, nil, nil)
+		TestLog("TestStringify", t, tt.incoming, got, tt.want, tt.description)
+		TestLog("TestStringify error", t, tt.incoming, err, nil, tt.description)
+	}
+}
+
+func TestStringifyUndefined(t *testing.T) {
+	for _, v := range []interface{}{value.Undefined, func() {}, value.NewSymbol("x"), make(chan int)} {
+		got, err := Stringify(v, nil, nil)
+		TestLog("TestStringifyUndefined", t, v, []interface{}{got, err}, []interface{}{"", nil}, "undefined result")
+	}
+}
+
+func TestStringifySpace(t *testing.T) {
+	v := ordered("a", array.MakeArray(1, ordered("b", 2), array.MakeArray()), "c", "x", "d", map[string]int{})
+	tests := []struct {
+		space       interface{}
+		want        string
+		description string
+	}{
+		{space: 2, want: "{\n  \"a\": [\n    1,\n    {\n      \"b\": 2\n    },\n    []\n  ],\n  \"c\": \"x\",\n  \"d\": {}\n}", description: "2 spaces"},
+		{space: "\t", want: "{\n\t\"a\": [\n\t\t1,\n\t\t{\n\t\t\t\"b\": 2\n\t\t},\n\t\t[]\n\t],\n\t\"c\": \"x\",\n\t\"d\": {}\n}", description: "tab"},
+		{space: 0, want: `{"a":[1,{"b":2},[]],"c":"x","d":{}}`, description: "0 is no indent"},
+		{space: -3, want: `{"a":[1,{"b":2},[]],"c":"x","d":{}}`, description: "negative is no indent"},
+		{space: true, want: `{"a":[1,{"b":2},[]],"c":"x","d":{}}`, description: "other types are ignored"},
+	}
+
+	for _, tt := range tests {
+		got, _ := Stringify(v, nil, tt.space)
+		TestLog("TestStringifySpace", t, tt.space, got, tt.want, tt.description)
+	}
+
+	got, _ := Stringify(array.MakeArray(1), nil, 20)
+	TestLog("TestStringifySpace", t, 20, got, "[\n"+strings.Repeat(" ", 10)+"1\n]", "space is clamped to 10")
+	got, _ = Stringify(array.MakeArray(1), nil, "abcdefghijklmn")
+	TestLog("TestStringifySpace", t, "abcdefghijklmn", got, "[\nabcdefghij1\n]", "string is cut to 10 chars")
+	got, _ = Stringify(ordered("r", raw{}), nil, 2)
+	TestLog("TestStringifySpace", t, 2, got, "{\n  \"r\": {\n    \"a\": [\n      1,\n      2\n    ]\n  }\n}", "Marshaler is indented")
+}
+
+func TestStringifyReplacer(t *testing.T) {
+	v := ordered("a", 1, "b", "x", "c", ordered("a", 3, "d", 4))
+
+	var keys []string
+	got, _ := Stringify(v, func(key string, v interface{}) interface{} {
+		keys = append(keys, key)
+		if f, ok := v.(int); ok {
+			return f * 2
+		}
+		if key == "b" {
+			return value.Undefined
+		}
+		return v
+	}, nil)
+	TestLog("TestStringifyReplacer", t, "func", got, `{"a":2,"c":{"a":6,"d":8}}`, "replacer function")
+	TestLog("TestStringifyReplacer", t, "func", keys, []string{"", "a", "b", "c", "a", "d"}, "replacer is called for root & every property")
+
+	got, _ = Stringify(v, array.MakeArray("c", "a", 5, "a", true), nil)
+	TestLog("TestStringifyReplacer", t, "allow list", got, `{"c":{"a":3},"a":1}`, "allow list order")
+
+	got, _ = Stringify(array.MakeArray(ordered("1", "x", "2", "y")), array.MakeArray(1), nil)
+	TestLog("TestStringifyReplacer", t, "allow list", got, `[{"1":"x"}]`, "numbers in allow list, arrays are not filtered")
+
+	got, _ = Stringify(1, Replacer(func(key string, v interface{}) interface{} { return value.Undefined }), nil)
+	TestLog("TestStringifyReplacer", t, "root", got, "", "undefined root")
+}
+
+func TestStringifyError(t *testing.T) {
+	cyclic := array.MakeArray(1)
+	cyclic.Push(array.MakeArray(cyclic))
+	m := map[string]interface{}{}
+	m["self"] = m
+	type node struct{ Next *node }
+	n := &node{}
+	n.Next = n
+
+	tests := []struct {
+		incoming    interface{}
+		want        error
+		description string
+	}{
+		{incoming: big.NewInt(1), want: &array.TypeError{Message: "Do not know how to serialize a BigInt"}, description: "BigInt"},
+		{incoming: value.NewArray(value.BigInt(big.NewInt(1))), want: &array.TypeError{Message: "Do not know how to serialize a BigInt"}, description: "BigInt Value"},
+		{incoming: cyclic, want: &array.TypeError{Message: "Converting circular structure to JSON"}, description: "cyclic Array"},
+		{incoming: m, want: &array.TypeError{Message: "Converting circular structure to JSON"}, description: "cyclic map"},
+		{incoming: n, want: &array.TypeError{Message: "Converting circular structure to JSON"}, description: "cyclic pointer"},
+	}
+
+	for _, tt := range tests {
+		got, err := Stringify(tt.incoming, nil, nil)
+		TestLog("TestStringifyError", t, tt.description, []interface{}{got, err}, []interface{}{"", tt.want}, tt.description)
+	}
+
+	shared := array.MakeArray(1)
+	got, err := Stringify(array.MakeArray(shared, shared), nil, nil)
+	TestLog("TestStringifyError", t, "shared", []interface{}{got, err}, []interface{}{"[[1],[1]]", nil}, "shared reference is not cycle")
+}
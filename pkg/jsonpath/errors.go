@@ -0,0 +1,19 @@
+package jsonpath
+
+import "github.com/miron-developer/golang-js-utils/pkg/array"
+
+// SyntaxError is error of invalid or ill-typed query, like js SyntaxError
+// 	Offset is index of char in query where error is found
+type SyntaxError struct {
+	Message string
+	Offset  int
+}
+
+func (e *SyntaxError) Error() string {
+	return "SyntaxError: " + e.Message
+}
+
+// Unwrap return array.SyntaxError of message, so errors.As catch it like other SyntaxErrors
+func (e *SyntaxError) Unwrap() error {
+	return &array.SyntaxError{Message: e.Message}
+}
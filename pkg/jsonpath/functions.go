@@ -0,0 +1,231 @@
+package jsonpath
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+	"github.com/miron-developer/golang-js-utils/pkg/regexp"
+)
+
+// valueType is type of function parameter or result, like RFC 9535 type system
+type valueType int
+
+const (
+	typeValue valueType = iota
+	typeLogical
+	typeNodes
+)
+
+// nothing is absence of value, like RFC 9535 Nothing
+type nothing struct{}
+
+type function struct {
+	params []valueType
+	result valueType
+	// call get values of ValueType, bool of LogicalType & []node of NodesType
+	call func(args []interface{}) interface{}
+	// prepare is optional compile time processing of well-typed arguments
+	prepare func(args []operand)
+}
+
+// functions are function extensions of RFC 9535
+var functions = map[string]*function{
+	"length": {params: []valueType{typeValue}, result: typeValue, call: length},
+	"count":  {params: []valueType{typeNodes}, result: typeValue, call: count},
+	"match":  {params: []valueType{typeValue, typeValue}, result: typeLogical, call: match, prepare: prepareMatch},
+	"search": {params: []valueType{typeValue, typeValue}, result: typeLogical, call: search, prepare: prepareSearch},
+	"value":  {params: []valueType{typeNodes}, result: typeValue, call: value},
+}
+
+// length return count of chars of string, elements of array or members of object
+func length(args []interface{}) interface{} {
+	switch v := args[0].(type) {
+	case string:
+		return float64(utf8.RuneCountInString(v))
+	case *array.Array:
+		return float64(len(v.Items))
+	}
+	if jsondoc.IsObject(args[0]) {
+		return float64(len(jsondoc.MemberKeys(args[0])))
+	}
+	return nothing{}
+}
+
+func count(args []interface{}) interface{} {
+	return float64(len(args[0].([]node)))
+}
+
+// value return value of single node or nothing
+func value(args []interface{}) interface{} {
+	if nodes := args[0].([]node); len(nodes) == 1 {
+		return nodes[0].value
+	}
+	return nothing{}
+}
+
+// match check is whole string matched by I-Regexp
+func match(args []interface{}) interface{} {
+	return test(args, true)
+}
+
+// search check is substring of string matched by I-Regexp
+func search(args []interface{}) interface{} {
+	return test(args, false)
+}
+
+// pattern is I-Regexp of literal compiled with query; nil re is invalid pattern
+type pattern struct {
+	re *regexp.RegExp
+}
+
+func prepareMatch(args []operand) {
+	preparePattern(args, true)
+}
+
+func prepareSearch(args []operand) {
+	preparePattern(args, false)
+}
+
+// preparePattern replace literal pattern by compiled one
+func preparePattern(args []operand, whole bool) {
+	if l, ok := args[1].(literal); ok {
+		if s, ok := l.value.(string); ok {
+			args[1] = literal{value: pattern{re: compileRegexp(s, whole)}}
+		}
+	}
+}
+
+// test check is string matched by pattern
+// 	false for non-string, invalid pattern or match error, like ErrStepLimit of too much backtracking
+func test(args []interface{}, whole bool) bool {
+	s, ok := args[0].(string)
+	if !ok {
+		return false
+	}
+	var re *regexp.RegExp
+	switch p := args[1].(type) {
+	case pattern:
+		re = p.re
+	case string:
+		re = regexps.get(p, whole)
+	}
+	if re == nil {
+		return false
+	}
+	matched, err := re.Test(s)
+	return err == nil && matched
+}
+
+// maxRegexps is size of cache of patterns got from documents
+const maxRegexps = 64
+
+type regexpKey struct {
+	pattern string
+	whole   bool
+}
+
+type regexpEntry struct {
+	key regexpKey
+	re  *regexp.RegExp
+}
+
+// regexpCache is LRU cache of compiled patterns got from documents, nil for invalid pattern
+type regexpCache struct {
+	mu      sync.Mutex
+	order   *list.List
+	entries map[regexpKey]*list.Element
+}
+
+var regexps = &regexpCache{order: list.New(), entries: map[regexpKey]*list.Element{}}
+
+// get return compiled pattern; least recently used pattern is evicted from full cache
+func (c *regexpCache) get(pattern string, whole bool) *regexp.RegExp {
+	key := regexpKey{pattern: pattern, whole: whole}
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.order.MoveToFront(e)
+		c.mu.Unlock()
+		return e.Value.(*regexpEntry).re
+	}
+	c.mu.Unlock()
+
+	re := compileRegexp(pattern, whole)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.order.PushFront(&regexpEntry{key: key, re: re})
+		if c.order.Len() > maxRegexps {
+			last := c.order.Back()
+			c.order.Remove(last)
+			delete(c.entries, last.Value.(*regexpEntry).key)
+		}
+	}
+	return re
+}
+
+// compileRegexp return RegExp of I-Regexp pattern, like RFC 9485; nil if pattern is invalid
+// 	"." doesn't match line terminators & other chars are literal outside of classes
+func compileRegexp(pattern string, whole bool) *regexp.RegExp {
+	source, ok := iRegexp(pattern)
+	if !ok {
+		return nil
+	}
+	if whole {
+		source = "^(?:" + source + ")$"
+	}
+	re, _ := regexp.New(source, "u")
+	return re
+}
+
+// iRegexp return ECMAScript source of I-Regexp; ok is false for syntax not in I-Regexp
+func iRegexp(pattern string) (string, bool) {
+	var b strings.Builder
+	inClass := false
+	rs := []rune(pattern)
+	for i := 0; i < len(rs); i++ {
+		c := rs[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(rs) {
+				return "", false
+			}
+			e := rs[i+1]
+			switch {
+			case e == 'p' || e == 'P':
+			case strings.ContainsRune(`()*+-.?[\]^{|}nrt`, e):
+			default:
+				// multi-char escapes & backreferences aren't in I-Regexp
+				return "", false
+			}
+			b.WriteRune(c)
+			b.WriteRune(e)
+			i++
+			continue
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			if i+1 < len(rs) && rs[i+1] == '^' {
+				b.WriteString("[^")
+				i++
+				continue
+			}
+		case c == '.':
+			b.WriteString(`[^\n\r]`)
+			continue
+		case c == '^' || c == '$':
+			b.WriteRune('\\')
+		case c == '(' && i+1 < len(rs) && rs[i+1] == '?':
+			// lookarounds & non-capturing groups aren't in I-Regexp
+			return "", false
+		}
+		b.WriteRune(c)
+	}
+	return b.String(), !inClass
+}
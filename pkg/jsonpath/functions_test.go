@@ -0,0 +1,114 @@
+package jsonpath
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/regexp"
+)
+
+func TestIRegexp(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   string
+		ok       bool
+		descr    string
+	}{
+		{`a.c`, `a[^\n\r]c`, true, "dot"},
+		{`[.^$]`, `[.^$]`, true, "class"},
+		{`[^a]`, `[^a]`, true, "negated class"},
+		{`^a$`, `\^a\$`, true, "anchors are literal"},
+		{`\p{Lu}\.`, `\p{Lu}\.`, true, "escapes"},
+		{`(?:a)`, ``, false, "non-capturing group"},
+		{`\d`, ``, false, "multi-char escape"},
+		{`(a)\1`, ``, false, "backreference"},
+		{`[a`, ``, false, "unclosed class"},
+		{`a\`, ``, false, "trailing backslash"},
+	}
+
+	for _, v := range tests {
+		got, ok := iRegexp(v.incoming)
+		TestLog("iRegexp", t, v.incoming, ok, v.ok, v.descr)
+		if ok {
+			TestLog("iRegexp", t, v.incoming, got, v.except, v.descr)
+		}
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	doc := mustParse(t, `[
+		{"s": "abé", "p": "a.é"},
+		{"s": "a\nb", "p": "a.b"},
+		{"s": "xabcx", "p": "abc"},
+		{"s": "abc", "p": "[a"},
+		{"s": 1, "p": "1"},
+		{"s": [1, 2, 3], "p": "a"},
+		{"s": {"a": 1, "b": 2}, "p": "a"}
+	]`)
+	tests := []struct {
+		incoming string
+		except   []string
+		descr    string
+	}{
+		{`$[?match(@.s, @.p)]`, []string{`$[0]`}, "match whole string"},
+		{`$[?search(@.s, @.p)]`, []string{`$[0]`, `$[2]`}, "search substring"},
+		{`$[?!match(@.s, @.p)]`, []string{`$[1]`, `$[2]`, `$[3]`, `$[4]`, `$[5]`, `$[6]`}, "not matched"},
+		{`$[?length(@.s) == 3]`, []string{`$[0]`, `$[1]`, `$[3]`, `$[5]`}, "length of string & array"},
+		{`$[?length(@.s) == 2]`, []string{`$[6]`}, "length of object"},
+		{`$[?length(@.s) == length(@.x)]`, []string{`$[4]`}, "length of number & nothing are nothing"},
+		{`$[?count(@.s.*) == 3]`, []string{`$[5]`}, "count"},
+		{`$[?count(@..*) == 2]`, []string{`$[0]`, `$[1]`, `$[2]`, `$[3]`, `$[4]`}, "count of descendants"},
+		{`$[?value(@.s.*) == 1]`, nil, "value of many nodes"},
+		{`$[?value(@.s) == 1]`, []string{`$[4]`}, "value of single node"},
+	}
+
+	for _, v := range tests {
+		q, err := Compile(v.incoming)
+		if !TestLog("Compile", t, v.incoming, err, nil, v.descr) {
+			continue
+		}
+		except := v.except
+		if except == nil {
+			except = []string{}
+		}
+		TestLog("Query.Select", t, v.incoming, paths(q.Select(doc)), except, v.descr)
+	}
+}
+
+func TestPatternCompile(t *testing.T) {
+	q := MustCompile(`$[?match(@.s, 'a.c')]`)
+	f := q.segments[0].selectors[0].(filterSelector).expr.(testExpr).operand.(*funcExpr)
+	p, ok := f.args[1].(literal).value.(pattern)
+	TestLog("Compile", t, q, ok, true, "literal pattern is compiled with query")
+	TestLog("Compile", t, q, p.re != nil, true, "valid pattern")
+
+	q = MustCompile(`$[?search(@.s, '(?:a)')]`)
+	f = q.segments[0].selectors[0].(filterSelector).expr.(testExpr).operand.(*funcExpr)
+	p, _ = f.args[1].(literal).value.(pattern)
+	TestLog("Compile", t, q, p.re == nil, true, "invalid pattern is compiled to nil")
+}
+
+func TestPatternCache(t *testing.T) {
+	q := MustCompile(`$[?match(@.s, @.p)]`)
+	for i := 0; i < maxRegexps*2; i++ {
+		doc := mustParse(t, `[{"s": "a`+strconv.Itoa(i)+`", "p": "a`+strconv.Itoa(i)+`"}]`)
+		TestLog("Query.Select", t, i, paths(q.Select(doc)), []string{`$[0]`}, "pattern of document")
+	}
+	regexps.mu.Lock()
+	n, entries := regexps.order.Len(), len(regexps.entries)
+	regexps.mu.Unlock()
+	TestLog("regexps", t, maxRegexps, n, maxRegexps, "cache is bounded")
+	TestLog("regexps", t, maxRegexps, entries, maxRegexps, "cache index is bounded")
+}
+
+func TestPatternStepLimit(t *testing.T) {
+	limit := regexp.DefaultStepLimit
+	regexp.DefaultStepLimit = 1000
+	defer func() { regexp.DefaultStepLimit = limit }()
+
+	doc := mustParse(t, `[{"s": "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}]`)
+	q := MustCompile(`$[?search(@.s, '(a*)*b')]`)
+	TestLog("search", t, q, paths(q.Select(doc)), []string{}, "step limit is not matched")
+	q = MustCompile(`$[?!search(@.s, '(a*)*b')]`)
+	TestLog("search", t, q, paths(q.Select(doc)), []string{`$[0]`}, "step limit is false")
+}
@@ -0,0 +1,385 @@
+package jsonpath
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/jsondoc"
+)
+
+// documents are same as documents of json package, like parsed by json.Parse
+
+// Query is compiled JSONPath query, like RFC 9535
+// 	Query is immutable, so it can be reused & used concurrently
+type Query struct {
+	source   string
+	segments []segment
+}
+
+// Node is value selected by query with its normalized path, like $['store']['book'][0]
+type Node struct {
+	Path  string
+	Value interface{}
+}
+
+// Compile return Query of JSONPath, like "$.store.book[?@.price < 10].title"
+// 	supported are names, wildcards, indexes, slices, filters & descendant segments,
+// 	functions length, count, match, search & value; match & search use I-Regexp,
+// 	literal patterns are compiled with query, patterns of document are cached in small LRU
+// 	return SyntaxError for invalid or ill-typed query
+func Compile(query string) (*Query, error) {
+	p := &parser{src: []rune(query), query: query}
+	segs, err := p.root()
+	if err != nil {
+		return nil, err
+	}
+	return &Query{source: query, segments: segs}, nil
+}
+
+// MustCompile is like Compile but panics if query is invalid
+func MustCompile(query string) *Query {
+	q, err := Compile(query)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String return source of query
+func (q *Query) String() string {
+	return q.source
+}
+
+// Select return Array of Node of values selected in doc, in order of selection
+func (q *Query) Select(doc interface{}) *array.Array {
+	r := array.NewArray()
+	for _, n := range q.nodes(doc) {
+		r.Items = append(r.Items, array.ArrayItem{Data: Node{Path: n.path(), Value: n.value}})
+	}
+	return r
+}
+
+// Values return Array of values selected in doc, in order of selection
+func (q *Query) Values(doc interface{}) *array.Array {
+	r := array.NewArray()
+	for _, n := range q.nodes(doc) {
+		r.Items = append(r.Items, array.ArrayItem{Data: n.value})
+	}
+	return r
+}
+
+func (q *Query) nodes(doc interface{}) []node {
+	root := node{value: doc}
+	e := &evaluator{root: root}
+	return e.segments(q.segments, []node{root})
+}
+
+// node is value of document with its location
+type node struct {
+	value interface{}
+	loc   *location
+}
+
+// location is position of node in its parent
+type location struct {
+	parent *location
+	name   string
+	index  int
+	// isIndex is true for array element
+	isIndex bool
+}
+
+// path return normalized path of node, like RFC 9535
+func (n node) path() string {
+	var locs []*location
+	for l := n.loc; l != nil; l = l.parent {
+		locs = append(locs, l)
+	}
+	var b strings.Builder
+	b.WriteByte('$')
+	for i := len(locs) - 1; i >= 0; i-- {
+		l := locs[i]
+		b.WriteByte('[')
+		if l.isIndex {
+			b.WriteString(strconv.Itoa(l.index))
+		} else {
+			quoteName(&b, l.name)
+		}
+		b.WriteByte(']')
+	}
+	return b.String()
+}
+
+const hex = "0123456789abcdef"
+
+// quoteName write name in single quotes with escapes of normalized path
+func quoteName(b *strings.Builder, name string) {
+	b.WriteByte('\'')
+	for _, c := range name {
+		switch {
+		case c == '\'' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		case c == '\b':
+			b.WriteString(`\b`)
+		case c == '\f':
+			b.WriteString(`\f`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c < 0x20:
+			b.WriteString(`\u00`)
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0xf])
+		default:
+			b.WriteRune(c)
+		}
+	}
+	b.WriteByte('\'')
+}
+
+func (n node) child(name string, v interface{}) node {
+	return node{value: v, loc: &location{parent: n.loc, name: name}}
+}
+
+func (n node) element(i int, v interface{}) node {
+	return node{value: v, loc: &location{parent: n.loc, index: i, isIndex: true}}
+}
+
+// children return elements of array or members of object
+func (n node) children() []node {
+	if arr, ok := n.value.(*array.Array); ok {
+		r := make([]node, len(arr.Items))
+		for i, item := range arr.Items {
+			r[i] = n.element(i, item.Data)
+		}
+		return r
+	}
+	keys := jsondoc.MemberKeys(n.value)
+	r := make([]node, len(keys))
+	for i, k := range keys {
+		v, _ := jsondoc.Member(n.value, k)
+		r[i] = n.child(k, v)
+	}
+	return r
+}
+
+type evaluator struct {
+	root node
+}
+
+func (e *evaluator) segments(segs []segment, nodes []node) []node {
+	for _, s := range segs {
+		var next []node
+		for _, n := range nodes {
+			if s.descendant {
+				e.descend(n, func(d node) {
+					next = e.selectAll(s.selectors, d, next)
+				})
+			} else {
+				next = e.selectAll(s.selectors, n, next)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// descend call fn for n & all its descendants in document order
+func (e *evaluator) descend(n node, fn func(node)) {
+	fn(n)
+	for _, c := range n.children() {
+		e.descend(c, fn)
+	}
+}
+
+func (e *evaluator) selectAll(sels []selector, n node, r []node) []node {
+	for _, s := range sels {
+		r = e.selectOne(s, n, r)
+	}
+	return r
+}
+
+func (e *evaluator) selectOne(s selector, n node, r []node) []node {
+	switch s := s.(type) {
+	case nameSelector:
+		if v, ok := jsondoc.Member(n.value, string(s)); ok {
+			r = append(r, n.child(string(s), v))
+		}
+	case wildcardSelector:
+		r = append(r, n.children()...)
+	case indexSelector:
+		arr, ok := n.value.(*array.Array)
+		if !ok {
+			break
+		}
+		i := int64(s)
+		if i < 0 {
+			i += int64(len(arr.Items))
+		}
+		if i >= 0 && i < int64(len(arr.Items)) {
+			r = append(r, n.element(int(i), arr.Items[i].Data))
+		}
+	case sliceSelector:
+		if arr, ok := n.value.(*array.Array); ok {
+			for _, i := range s.indexes(int64(len(arr.Items))) {
+				r = append(r, n.element(int(i), arr.Items[i].Data))
+			}
+		}
+	case filterSelector:
+		for _, c := range n.children() {
+			if e.logical(s.expr, c) {
+				r = append(r, c)
+			}
+		}
+	}
+	return r
+}
+
+// indexes return selected indexes of array with length n, like RFC 9535 slice
+func (s sliceSelector) indexes(n int64) []int64 {
+	if s.step == 0 {
+		return nil
+	}
+	normalize := func(i int64) int64 {
+		if i < 0 {
+			return n + i
+		}
+		return i
+	}
+	clamp := func(i, lo, hi int64) int64 {
+		return max(lo, min(i, hi))
+	}
+	var r []int64
+	if s.step > 0 {
+		start, end := int64(0), n
+		if s.hasStart {
+			start = clamp(normalize(s.start), 0, n)
+		}
+		if s.hasEnd {
+			end = clamp(normalize(s.end), 0, n)
+		}
+		for i := start; i < end; i += s.step {
+			r = append(r, i)
+		}
+		return r
+	}
+	start, end := n-1, int64(-1)
+	if s.hasStart {
+		start = clamp(normalize(s.start), -1, n-1)
+	}
+	if s.hasEnd {
+		end = clamp(normalize(s.end), -1, n-1)
+	}
+	for i := start; i > end; i += s.step {
+		r = append(r, i)
+	}
+	return r
+}
+
+// logical return result of filter expression for current node
+func (e *evaluator) logical(l logical, current node) bool {
+	switch l := l.(type) {
+	case orExpr:
+		for _, x := range l {
+			if e.logical(x, current) {
+				return true
+			}
+		}
+		return false
+	case andExpr:
+		for _, x := range l {
+			if !e.logical(x, current) {
+				return false
+			}
+		}
+		return true
+	case notExpr:
+		return !e.logical(l.expr, current)
+	case testExpr:
+		switch r := e.operand(l.operand, current, false).(type) {
+		case bool:
+			return r
+		case []node:
+			return len(r) > 0
+		}
+		return false
+	case comparison:
+		a := e.operand(l.left, current, true)
+		b := e.operand(l.right, current, true)
+		return compare(l.op, a, b)
+	}
+	return false
+}
+
+// operand return value of operand: value or nothing if asValue, otherwise nodes of query
+func (e *evaluator) operand(o operand, current node, asValue bool) interface{} {
+	switch o := o.(type) {
+	case literal:
+		return o.value
+	case *queryExpr:
+		start := e.root
+		if o.relative {
+			start = current
+		}
+		nodes := e.segments(o.segments, []node{start})
+		if !asValue {
+			return nodes
+		}
+		if len(nodes) == 1 {
+			return nodes[0].value
+		}
+		return nothing{}
+	case *funcExpr:
+		args := make([]interface{}, len(o.args))
+		for i, a := range o.args {
+			switch o.fn.params[i] {
+			case typeValue:
+				args[i] = e.operand(a, current, true)
+			case typeNodes:
+				args[i] = e.operand(a, current, false)
+			case typeLogical:
+				args[i] = e.logical(a, current)
+			}
+		}
+		return o.fn.call(args)
+	}
+	return nothing{}
+}
+
+// compare return result of comparison, like RFC 9535
+// 	only numbers & strings are ordered; nothing is equal only to nothing
+func compare(op string, a, b interface{}) bool {
+	switch op {
+	case "==":
+		return jsondoc.Equal(a, b)
+	case "!=":
+		return !jsondoc.Equal(a, b)
+	case "<":
+		return less(a, b)
+	case "<=":
+		return less(a, b) || jsondoc.Equal(a, b)
+	case ">":
+		return less(b, a)
+	case ">=":
+		return less(b, a) || jsondoc.Equal(a, b)
+	}
+	return false
+}
+
+func less(a, b interface{}) bool {
+	if fa, ok := conv.Number(a); ok {
+		fb, ok := conv.Number(b)
+		return ok && fa < fb
+	}
+	sa, ok := a.(string)
+	sb, isString := b.(string)
+	// strings are compared by code points
+	return ok && isString && sa < sb
+}
+
@@ -0,0 +1,193 @@
+package jsonpath
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/json"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+// mustParse return parsed JSON text of test document
+func mustParse(t *testing.T, text string) interface{} {
+	v, err := json.Parse(text, nil)
+	if err != nil {
+		t.Fatalf("Parse(%v): %v", text, err)
+	}
+	return v
+}
+
+// paths return normalized paths of selected nodes
+func paths(arr *array.Array) []string {
+	r := []string{}
+	for _, item := range arr.Items {
+		r = append(r, item.Data.(Node).Path)
+	}
+	return r
+}
+
+const store = `{ "store": {
+	"book": [
+		{ "category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95 },
+		{ "category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99 },
+		{ "category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99 },
+		{ "category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99 }
+	],
+	"bicycle": { "color": "red", "price": 399 }
+} }`
+
+func TestSelect(t *testing.T) {
+	doc := mustParse(t, store)
+	tests := []struct {
+		incoming string
+		except   []string
+		descr    string
+	}{
+		{`$`, []string{`$`}, "root"},
+		{`$.store.book[*].author`, []string{
+			`$['store']['book'][0]['author']`, `$['store']['book'][1]['author']`,
+			`$['store']['book'][2]['author']`, `$['store']['book'][3]['author']`,
+		}, "authors of all books"},
+		{`$..author`, []string{
+			`$['store']['book'][0]['author']`, `$['store']['book'][1]['author']`,
+			`$['store']['book'][2]['author']`, `$['store']['book'][3]['author']`,
+		}, "all authors"},
+		{`$.store.*`, []string{`$['store']['book']`, `$['store']['bicycle']`}, "all things in store"},
+		{`$.store..price`, []string{
+			`$['store']['book'][0]['price']`, `$['store']['book'][1]['price']`,
+			`$['store']['book'][2]['price']`, `$['store']['book'][3]['price']`,
+			`$['store']['bicycle']['price']`,
+		}, "prices of everything"},
+		{`$..book[2]`, []string{`$['store']['book'][2]`}, "third book"},
+		{`$..book[-1]`, []string{`$['store']['book'][3]`}, "last book"},
+		{`$..book[0,1]`, []string{`$['store']['book'][0]`, `$['store']['book'][1]`}, "first two books by union"},
+		{`$..book[:2]`, []string{`$['store']['book'][0]`, `$['store']['book'][1]`}, "first two books by slice"},
+		{`$..book[?@.isbn]`, []string{`$['store']['book'][2]`, `$['store']['book'][3]`}, "books with isbn"},
+		{`$..book[?(@.price < 10)].title`, []string{`$['store']['book'][0]['title']`, `$['store']['book'][2]['title']`}, "cheap books"},
+		{`$..book[?@.price<$.store.bicycle.price && @.category == 'fiction'][ 'title' ]`, []string{
+			`$['store']['book'][1]['title']`, `$['store']['book'][2]['title']`, `$['store']['book'][3]['title']`,
+		}, "and with root query"},
+		{`$..book[?!(@.price > 9 || @.isbn)]`, []string{`$['store']['book'][0]`}, "not & or"},
+		{`$.store.book[?length(@.author) == 12]`, []string{`$['store']['book'][1]`}, "length"},
+		{`$.store[?count(@.*) == 2]`, []string{`$['store']['bicycle']`}, "count"},
+		{`$..book[?match(@.author, 'J.*')]`, []string{`$['store']['book'][3]`}, "match"},
+		{`$..book[?search(@.title, 'Century|[Hh]onour')]`, []string{`$['store']['book'][0]`, `$['store']['book'][1]`}, "search"},
+		{`$..book[?value(@..isbn) == '0-553-21311-3']`, []string{`$['store']['book'][2]`}, "value"},
+		{`$.store.missing`, []string{}, "missing member"},
+	}
+
+	for _, v := range tests {
+		q, err := Compile(v.incoming)
+		if !TestLog("Compile", t, v.incoming, err, nil, v.descr) {
+			continue
+		}
+		TestLog("Query.Select", t, v.incoming, paths(q.Select(doc)), v.except, v.descr)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	doc := mustParse(t, `["a", "b", "c", "d", "e", "f", "g"]`)
+	tests := []struct {
+		incoming string
+		except   []interface{}
+		descr    string
+	}{
+		{`$[1:3]`, []interface{}{"b", "c"}, "start & end"},
+		{`$[5:]`, []interface{}{"f", "g"}, "no end"},
+		{`$[1:5:2]`, []interface{}{"b", "d"}, "step"},
+		{`$[5:1:-2]`, []interface{}{"f", "d"}, "negative step"},
+		{`$[::-1]`, []interface{}{"g", "f", "e", "d", "c", "b", "a"}, "reverse"},
+		{`$[-2:]`, []interface{}{"f", "g"}, "negative start"},
+		{`$[0:100]`, []interface{}{"a", "b", "c", "d", "e", "f", "g"}, "end out of range"},
+		{`$[::0]`, nil, "zero step"},
+		{`$[3:1]`, nil, "empty range"},
+		{`$[-8]`, nil, "index out of range"},
+	}
+
+	for _, v := range tests {
+		got := MustCompile(v.incoming).Values(doc)
+		var items []interface{}
+		for _, item := range got.Items {
+			items = append(items, item.Data)
+		}
+		TestLog("Query.Values", t, v.incoming, items, v.except, v.descr)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	doc := mustParse(t, `{"a": [3, 5, 1, 2, 4, 6, {"b": "j"}, {"b": "k"}, {"b": {}}, {"b": "kilo"}], "o": {"p": 1, "q": 2, "r": 3}, "e": [[1, 2], {"x": 1, "y": 2}]}`)
+	tests := []struct {
+		incoming string
+		except   []string
+		descr    string
+	}{
+		{`$.a[?@.b == 'kilo']`, []string{`$['a'][9]`}, "member value comparison"},
+		{`$.a[?@>3.5]`, []string{`$['a'][1]`, `$['a'][4]`, `$['a'][5]`}, "array value comparison"},
+		{`$.a[?@.b]`, []string{`$['a'][6]`, `$['a'][7]`, `$['a'][8]`, `$['a'][9]`}, "existence"},
+		{`$[?@.*]`, []string{`$['a']`, `$['o']`, `$['e']`}, "non-empty containers"},
+		{`$.a[?@ < 2 || @.b == "k"]`, []string{`$['a'][2]`, `$['a'][7]`}, "or"},
+		{`$.a[?@.b > 'j']`, []string{`$['a'][7]`, `$['a'][9]`}, "string order"},
+		{`$.o[?@<3, ?@<3]`, []string{`$['o']['p']`, `$['o']['q']`, `$['o']['p']`, `$['o']['q']`}, "filters in union"},
+		{`$.a[?@ == @.x]`, nil, "nothing isn't equal to value"},
+		{`$.a[?@.x == @.y]`, []string{
+			`$['a'][0]`, `$['a'][1]`, `$['a'][2]`, `$['a'][3]`, `$['a'][4]`,
+			`$['a'][5]`, `$['a'][6]`, `$['a'][7]`, `$['a'][8]`, `$['a'][9]`,
+		}, "nothing is equal to nothing"},
+		{`$.e[?@ == $.e[0]]`, []string{`$['e'][0]`}, "array equality"},
+		{`$.e[?@ == $.e[1]]`, []string{`$['e'][1]`}, "object equality"},
+		{`$.a[?@ <= 1]`, []string{`$['a'][2]`}, "less or equal"},
+		{`$.a[?@ != 3 && @ >= 5]`, []string{`$['a'][1]`, `$['a'][5]`}, "not equal & greater or equal"},
+		{`$.a[?@.b == null]`, nil, "null"},
+		{`$.a[?true == true]`, []string{
+			`$['a'][0]`, `$['a'][1]`, `$['a'][2]`, `$['a'][3]`, `$['a'][4]`,
+			`$['a'][5]`, `$['a'][6]`, `$['a'][7]`, `$['a'][8]`, `$['a'][9]`,
+		}, "literal comparison"},
+	}
+
+	for _, v := range tests {
+		q, err := Compile(v.incoming)
+		if !TestLog("Compile", t, v.incoming, err, nil, v.descr) {
+			continue
+		}
+		except := v.except
+		if except == nil {
+			except = []string{}
+		}
+		TestLog("Query.Select", t, v.incoming, paths(q.Select(doc)), except, v.descr)
+	}
+}
+
+func TestNormalizedPath(t *testing.T) {
+	doc := mustParse(t, `{"a'b": {"c\\d": {"\n\u0001": [0, 1]}}}`)
+	q := MustCompile(`$..[1]`)
+	TestLog("Query.Select", t, q, paths(q.Select(doc)), []string{`$['a\'b']['c\\d']['\n\u0001'][1]`}, "escaped names")
+
+	q = MustCompile(`$["a'b"]['c\\d']`)
+	TestLog("Query.Values", t, q, q.Values(doc).Items[0].Data, mustParse(t, `{"\n\u0001": [0, 1]}`), "escaped name selectors")
+	TestLog("Query.String", t, q, q.String(), `$["a'b"]['c\\d']`, "source")
+}
+
+func TestMapDocument(t *testing.T) {
+	doc := map[string]interface{}{
+		"b": []int{1},
+		"a": map[string]interface{}{"x": 1},
+		"c": array.NewArray().Push(map[string]interface{}{"x": 2}),
+	}
+	q := MustCompile(`$..x`)
+	got := q.Select(doc)
+	except := []interface{}{Node{Path: `$['a']['x']`, Value: 1}, Node{Path: `$['c'][0]['x']`, Value: 2}}
+	var items []interface{}
+	for _, item := range got.Items {
+		items = append(items, item.Data)
+	}
+	TestLog("Query.Select", t, q, items, except, "maps in sorted key order")
+}
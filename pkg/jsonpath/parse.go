@@ -0,0 +1,697 @@
+package jsonpath
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// query syntax, like RFC 9535
+
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector interface{}
+
+type (
+	nameSelector     string
+	wildcardSelector struct{}
+	indexSelector    int64
+	sliceSelector    struct {
+		start, end       int64
+		hasStart, hasEnd bool
+		step             int64
+	}
+	filterSelector struct {
+		expr logical
+	}
+)
+
+// logical is expression of LogicalType
+type logical interface{}
+
+type (
+	orExpr  []logical
+	andExpr []logical
+	notExpr struct {
+		expr logical
+	}
+	// testExpr is existence test of query or logical result of function
+	testExpr struct {
+		operand operand
+	}
+	comparison struct {
+		op          string
+		left, right operand
+	}
+)
+
+// operand is literal, query or function call
+type operand interface{}
+
+type (
+	literal struct {
+		value interface{}
+	}
+	queryExpr struct {
+		relative bool
+		segments []segment
+	}
+	funcExpr struct {
+		name string
+		fn   *function
+		args []operand
+	}
+)
+
+// singular check is query select at most one node, like RFC 9535 singular query
+func (q *queryExpr) singular() bool {
+	for _, s := range q.segments {
+		if s.descendant || len(s.selectors) != 1 {
+			return false
+		}
+		switch s.selectors[0].(type) {
+		case nameSelector, indexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// maxIndex is maximum of index & slice bounds, like I-JSON integers
+const maxIndex = 1<<53 - 1
+
+type parser struct {
+	src   []rune
+	pos   int
+	query string
+}
+
+func (p *parser) fail(format string, args ...interface{}) error {
+	return &SyntaxError{
+		Message: fmt.Sprintf(format, args...) + fmt.Sprintf(" at position %d of JSONPath %q", p.pos, p.query),
+		Offset:  p.pos,
+	}
+}
+
+// unexpected return SyntaxError of current char or end of query
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.src) {
+		return p.fail("Unexpected end")
+	}
+	return p.fail("Unexpected %q", p.src[p.pos])
+}
+
+func (p *parser) peek() rune {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return -1
+}
+
+func (p *parser) peekAt(i int) rune {
+	if p.pos+i < len(p.src) {
+		return p.src[p.pos+i]
+	}
+	return -1
+}
+
+func (p *parser) space() {
+	for {
+		switch p.peek() {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) expect(c rune) error {
+	if p.peek() != c {
+		return p.unexpected()
+	}
+	p.pos++
+	return nil
+}
+
+// root parse whole query
+func (p *parser) root() ([]segment, error) {
+	if err := p.expect('$'); err != nil {
+		return nil, err
+	}
+	segs, err := p.segments()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.unexpected()
+	}
+	return segs, nil
+}
+
+func (p *parser) segments() ([]segment, error) {
+	var segs []segment
+	for {
+		save := p.pos
+		p.space()
+		var s segment
+		var err error
+		switch {
+		case p.peek() == '.' && p.peekAt(1) == '.':
+			p.pos += 2
+			s, err = p.dotSegment(true)
+		case p.peek() == '.':
+			p.pos++
+			s, err = p.dotSegment(false)
+		case p.peek() == '[':
+			s.selectors, err = p.bracketed()
+		default:
+			p.pos = save
+			return segs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		segs = append(segs, s)
+	}
+}
+
+// dotSegment parse segment after "." or ".."
+func (p *parser) dotSegment(descendant bool) (segment, error) {
+	s := segment{descendant: descendant}
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		s.selectors = []selector{wildcardSelector{}}
+	case isNameFirst(c):
+		start := p.pos
+		for isNameFirst(p.peek()) || isDigit(p.peek()) {
+			p.pos++
+		}
+		s.selectors = []selector{nameSelector(p.src[start:p.pos])}
+	case c == '[' && descendant:
+		sels, err := p.bracketed()
+		if err != nil {
+			return s, err
+		}
+		s.selectors = sels
+	default:
+		return s, p.unexpected()
+	}
+	return s, nil
+}
+
+func isNameFirst(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' ||
+		c >= 0x80 && c <= 0xd7ff || c >= 0xe000 && c <= 0x10ffff
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *parser) bracketed() ([]selector, error) {
+	p.pos++
+	var sels []selector
+	for {
+		p.space()
+		sel, err := p.selector()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+		p.space()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return sels, nil
+		default:
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *parser) selector() (selector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		s, err := p.string()
+		return nameSelector(s), err
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+	case c == '?':
+		p.pos++
+		e, err := p.logical()
+		return filterSelector{expr: e}, err
+	}
+
+	start, hasStart, err := p.optionalInt()
+	if err != nil {
+		return nil, err
+	}
+	p.space()
+	if p.peek() != ':' {
+		if !hasStart {
+			return nil, p.unexpected()
+		}
+		return indexSelector(start), nil
+	}
+
+	s := sliceSelector{start: start, hasStart: hasStart, step: 1}
+	p.pos++
+	p.space()
+	if s.end, s.hasEnd, err = p.optionalInt(); err != nil {
+		return nil, err
+	}
+	p.space()
+	if p.peek() == ':' {
+		p.pos++
+		p.space()
+		step, hasStep, err := p.optionalInt()
+		if err != nil {
+			return nil, err
+		}
+		if hasStep {
+			s.step = step
+		}
+	}
+	return s, nil
+}
+
+// optionalInt parse integer without leading zeros if it is at current position
+func (p *parser) optionalInt() (int64, bool, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	if !isDigit(p.peek()) {
+		if p.pos > start {
+			return 0, false, p.unexpected()
+		}
+		return 0, false, nil
+	}
+	if p.peek() == '0' && (p.pos > start || isDigit(p.peekAt(1))) {
+		return 0, false, p.fail("Invalid integer")
+	}
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	n, err := strconv.ParseInt(string(p.src[start:p.pos]), 10, 64)
+	if err != nil || n > maxIndex || n < -maxIndex {
+		p.pos = start
+		return 0, false, p.fail("Integer out of range")
+	}
+	return n, true, nil
+}
+
+// string parse quoted string literal
+func (p *parser) string() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		c := p.peek()
+		switch {
+		case c < 0:
+			return "", p.fail("Unterminated string")
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c < 0x20:
+			return "", p.fail("Invalid control character in string")
+		case c == '\\':
+			p.pos++
+			r, err := p.escape(quote)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteRune(c)
+		p.pos++
+	}
+}
+
+func (p *parser) escape(quote rune) (rune, error) {
+	c := p.peek()
+	p.pos++
+	switch c {
+	case quote, '/', '\\':
+		return c, nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		r, err := p.hex4()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case r >= 0xdc00 && r <= 0xdfff:
+			return 0, p.fail("Invalid lone surrogate")
+		case r >= 0xd800 && r <= 0xdbff:
+			if p.peek() != '\\' || p.peekAt(1) != 'u' {
+				return 0, p.fail("Invalid lone surrogate")
+			}
+			p.pos += 2
+			lo, err := p.hex4()
+			if err != nil {
+				return 0, err
+			}
+			if lo < 0xdc00 || lo > 0xdfff {
+				return 0, p.fail("Invalid lone surrogate")
+			}
+			return (r-0xd800)<<10 + lo - 0xdc00 + 0x10000, nil
+		}
+		return r, nil
+	}
+	p.pos--
+	return 0, p.fail("Invalid escape")
+}
+
+func (p *parser) hex4() (rune, error) {
+	var r rune
+	for i := 0; i < 4; i++ {
+		c := p.peek()
+		var d rune
+		switch {
+		case isDigit(c):
+			d = c - '0'
+		case c >= 'a' && c <= 'f':
+			d = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			d = c - 'A' + 10
+		default:
+			return 0, p.fail("Invalid unicode escape")
+		}
+		r = r<<4 | d
+		p.pos++
+	}
+	return r, nil
+}
+
+// logical parse logical-or expression
+func (p *parser) logical() (logical, error) {
+	var or orExpr
+	for {
+		var and andExpr
+		for {
+			p.space()
+			e, err := p.basic()
+			if err != nil {
+				return nil, err
+			}
+			and = append(and, e)
+			save := p.pos
+			p.space()
+			if p.peek() != '&' || p.peekAt(1) != '&' {
+				p.pos = save
+				break
+			}
+			p.pos += 2
+		}
+		if len(and) == 1 {
+			or = append(or, and[0])
+		} else {
+			or = append(or, and)
+		}
+		save := p.pos
+		p.space()
+		if p.peek() != '|' || p.peekAt(1) != '|' {
+			p.pos = save
+			break
+		}
+		p.pos += 2
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+// basic parse parenthesized expression, comparison or test
+func (p *parser) basic() (logical, error) {
+	if p.peek() == '!' {
+		p.pos++
+		p.space()
+		if p.peek() == '(' {
+			e, err := p.paren()
+			return notExpr{expr: e}, err
+		}
+		e, err := p.test()
+		return notExpr{expr: e}, err
+	}
+	if p.peek() == '(' {
+		return p.paren()
+	}
+
+	start := p.pos
+	left, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	p.space()
+	op := p.comparisonOp()
+	if op == "" {
+		p.pos = start
+		return p.test()
+	}
+	p.pos += len(op)
+	p.space()
+	opPos := p.pos
+	right, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.comparable(left, start); err != nil {
+		return nil, err
+	}
+	if err := p.comparable(right, opPos); err != nil {
+		return nil, err
+	}
+	return comparison{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) paren() (logical, error) {
+	p.pos++
+	e, err := p.logical()
+	if err != nil {
+		return nil, err
+	}
+	p.space()
+	return e, p.expect(')')
+}
+
+// test parse query or function which is used as logical value
+func (p *parser) test() (logical, error) {
+	start := p.pos
+	o, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	switch o := o.(type) {
+	case *queryExpr:
+	case *funcExpr:
+		if o.fn.result == typeValue {
+			p.pos = start
+			return nil, p.fail("Result of function %s must be compared", o.name)
+		}
+	default:
+		p.pos = start
+		return nil, p.fail("Literal must be compared")
+	}
+	return testExpr{operand: o}, nil
+}
+
+var comparisonOps = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *parser) comparisonOp() string {
+	for _, op := range comparisonOps {
+		if strings.HasPrefix(string(p.src[p.pos:min(p.pos+2, len(p.src))]), op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// comparable check is operand comparable: literal, singular query or function of ValueType
+func (p *parser) comparable(o operand, pos int) error {
+	switch o := o.(type) {
+	case *queryExpr:
+		if !o.singular() {
+			return &SyntaxError{Message: fmt.Sprintf("Compared query must be singular at position %d of JSONPath %q", pos, p.query), Offset: pos}
+		}
+	case *funcExpr:
+		if o.fn.result != typeValue {
+			return &SyntaxError{Message: fmt.Sprintf("Result of function %s can't be compared at position %d of JSONPath %q", o.name, pos, p.query), Offset: pos}
+		}
+	}
+	return nil
+}
+
+// operand parse literal, query or function call
+func (p *parser) operand() (operand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		segs, err := p.segments()
+		return &queryExpr{relative: c == '@', segments: segs}, err
+	case c == '\'' || c == '"':
+		s, err := p.string()
+		return literal{value: s}, err
+	case c == '-' || isDigit(c):
+		return p.number()
+	case c >= 'a' && c <= 'z':
+		start := p.pos
+		for c := p.peek(); c >= 'a' && c <= 'z' || c == '_' || isDigit(c); c = p.peek() {
+			p.pos++
+		}
+		name := string(p.src[start:p.pos])
+		if p.peek() == '(' {
+			p.pos = start
+			return p.function(name)
+		}
+		switch name {
+		case "true":
+			return literal{value: true}, nil
+		case "false":
+			return literal{value: false}, nil
+		case "null":
+			return literal{value: nil}, nil
+		}
+		p.pos = start
+	}
+	return nil, p.unexpected()
+}
+
+// number parse number literal, like JSON number with -0
+func (p *parser) number() (operand, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	switch {
+	case p.peek() == '0':
+		p.pos++
+		if isDigit(p.peek()) {
+			return nil, p.fail("Invalid number")
+		}
+	case isDigit(p.peek()):
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+	default:
+		return nil, p.unexpected()
+	}
+	if p.peek() == '.' {
+		p.pos++
+		if !isDigit(p.peek()) {
+			return nil, p.unexpected()
+		}
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+	}
+	if p.peek() == 'e' || p.peek() == 'E' {
+		p.pos++
+		if p.peek() == '+' || p.peek() == '-' {
+			p.pos++
+		}
+		if !isDigit(p.peek()) {
+			return nil, p.unexpected()
+		}
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+	}
+	f, _ := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+	if math.IsInf(f, 0) {
+		p.pos = start
+		return nil, p.fail("Number out of range")
+	}
+	return literal{value: f}, nil
+}
+
+// function parse function call & check types of its arguments
+func (p *parser) function(name string) (operand, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, p.fail("Unknown function %s", name)
+	}
+	p.pos += len(name) + 1
+	f := &funcExpr{name: name, fn: fn}
+	p.space()
+	for p.peek() != ')' {
+		if len(f.args) > 0 {
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+			p.space()
+		}
+		argPos := p.pos
+		if len(f.args) == len(fn.params) {
+			return nil, p.fail("Too many arguments of function %s", name)
+		}
+		arg, err := p.argument(fn.params[len(f.args)])
+		if err != nil {
+			return nil, err
+		}
+		if err := p.checkArgument(f, arg, argPos); err != nil {
+			return nil, err
+		}
+		f.args = append(f.args, arg)
+		p.space()
+	}
+	if len(f.args) < len(fn.params) {
+		return nil, p.fail("Too few arguments of function %s", name)
+	}
+	if fn.prepare != nil {
+		fn.prepare(f.args)
+	}
+	p.pos++
+	return f, nil
+}
+
+// argument parse function argument; argument of LogicalType is logical expression
+func (p *parser) argument(t valueType) (operand, error) {
+	if t == typeLogical {
+		return p.logical()
+	}
+	return p.operand()
+}
+
+// checkArgument check is argument well-typed for parameter, like RFC 9535
+func (p *parser) checkArgument(f *funcExpr, arg operand, pos int) error {
+	t := f.fn.params[len(f.args)]
+	ok := true
+	switch a := arg.(type) {
+	case literal:
+		ok = t == typeValue
+	case *queryExpr:
+		ok = t == typeNodes || t == typeValue && a.singular()
+	case *funcExpr:
+		ok = a.fn.result == t
+	}
+	if !ok {
+		return &SyntaxError{
+			Message: fmt.Sprintf("Invalid argument %d of function %s at position %d of JSONPath %q", len(f.args)+1, f.name, pos, p.query),
+			Offset:  pos,
+		}
+	}
+	return nil
+}
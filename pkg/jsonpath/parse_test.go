@@ -0,0 +1,85 @@
+package jsonpath
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   string
+		descr    string
+	}{
+		{``, `SyntaxError: Unexpected end at position 0 of JSONPath ""`, "empty query"},
+		{`store`, `SyntaxError: Unexpected 's' at position 0 of JSONPath "store"`, "no root"},
+		{`$ `, `SyntaxError: Unexpected ' ' at position 1 of JSONPath "$ "`, "trailing space"},
+		{`$.a.`, `SyntaxError: Unexpected end at position 4 of JSONPath "$.a."`, "no member name"},
+		{`$..`, `SyntaxError: Unexpected end at position 3 of JSONPath "$.."`, "no descendant selector"},
+		{`$['a'`, `SyntaxError: Unexpected end at position 5 of JSONPath "$['a'"`, "unclosed bracket"},
+		{`$['\q']`, `SyntaxError: Invalid escape at position 4 of JSONPath "$['\\q']"`, "bad escape"},
+		{`$['\ud800']`, `SyntaxError: Invalid lone surrogate at position 9 of JSONPath "$['\\ud800']"`, "lone surrogate"},
+		{`$[01]`, `SyntaxError: Invalid integer at position 2 of JSONPath "$[01]"`, "leading zero"},
+		{`$[9007199254740992]`, `SyntaxError: Integer out of range at position 2 of JSONPath "$[9007199254740992]"`, "index out of range"},
+		{`$[?@.a == 01]`, `SyntaxError: Invalid number at position 11 of JSONPath "$[?@.a == 01]"`, "bad number"},
+		{`$[?(@.a]`, `SyntaxError: Unexpected ']' at position 7 of JSONPath "$[?(@.a]"`, "unclosed paren"},
+		{`$.a[?@.b == {}]`, `SyntaxError: Unexpected '{' at position 12 of JSONPath "$.a[?@.b == {}]"`, "object literal"},
+		{`$[?!@.a == 1]`, `SyntaxError: Unexpected '=' at position 8 of JSONPath "$[?!@.a == 1]"`, "negated comparison"},
+		{`$[?1]`, `SyntaxError: Literal must be compared at position 3 of JSONPath "$[?1]"`, "literal test"},
+		{`$[?@.* == 1]`, `SyntaxError: Compared query must be singular at position 3 of JSONPath "$[?@.* == 1]"`, "wildcard comparison"},
+		{`$[?@..a == 1]`, `SyntaxError: Compared query must be singular at position 3 of JSONPath "$[?@..a == 1]"`, "descendant comparison"},
+		{`$[?foo(@)]`, `SyntaxError: Unknown function foo at position 3 of JSONPath "$[?foo(@)]"`, "unknown function"},
+		{`$[?length(@.a)]`, `SyntaxError: Result of function length must be compared at position 3 of JSONPath "$[?length(@.a)]"`, "value function test"},
+		{`$[?match(@.a)]`, `SyntaxError: Too few arguments of function match at position 12 of JSONPath "$[?match(@.a)]"`, "too few arguments"},
+		{`$[?match(@.a, 'x', 1)]`, `SyntaxError: Too many arguments of function match at position 19 of JSONPath "$[?match(@.a, 'x', 1)]"`, "too many arguments"},
+		{`$[?count(1) == 1]`, `SyntaxError: Invalid argument 1 of function count at position 9 of JSONPath "$[?count(1) == 1]"`, "literal of nodes parameter"},
+		{`$[?length(@.*) == 1]`, `SyntaxError: Invalid argument 1 of function length at position 10 of JSONPath "$[?length(@.*) == 1]"`, "non-singular query of value parameter"},
+	}
+
+	for _, v := range tests {
+		q, err := Compile(v.incoming)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("Compile", t, v.incoming, msg, v.except, v.descr)
+		TestLog("Compile", t, v.incoming, q == nil, true, v.descr)
+	}
+
+	_, err := Compile("$[")
+	var se *array.SyntaxError
+	TestLog("Compile", t, "$[", errors.As(err, &se), true, "array.SyntaxError is unwrapped")
+}
+
+func TestMustCompile(t *testing.T) {
+	defer func() {
+		r := recover()
+		_, ok := r.(*SyntaxError)
+		TestLog("MustCompile", t, "$[", ok, true, "panic with SyntaxError")
+	}()
+	MustCompile("$[")
+}
+
+func TestSingular(t *testing.T) {
+	tests := []struct {
+		incoming string
+		except   bool
+		descr    string
+	}{
+		{`@`, true, "current node"},
+		{`@.a[0]['b']`, true, "names & indexes"},
+		{`@[0,1]`, false, "union"},
+		{`@[*]`, false, "wildcard"},
+		{`@[0:1]`, false, "slice"},
+		{`@..a`, false, "descendant"},
+	}
+
+	for _, v := range tests {
+		p := &parser{src: []rune(v.incoming), query: v.incoming}
+		o, err := p.operand()
+		TestLog("operand", t, v.incoming, err, nil, v.descr)
+		TestLog("singular", t, v.incoming, o.(*queryExpr).singular(), v.except, v.descr)
+	}
+}
@@ -0,0 +1,192 @@
+package jsstring
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// JSString is js string: sequence of UTF-16 code units where lone surrogates are allowed
+type JSString []uint16
+
+// New return JSString of go string
+// 	surrogates encoded in WTF-8 are decoded as code units, so String & New are lossless
+// 	invalid UTF-8 bytes are decoded as U+FFFD
+func New(s string) JSString {
+	r := make(JSString, 0, len(s))
+	for i := 0; i < len(s); {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			if u, ok := decodeSurrogate(s[i:]); ok {
+				r = append(r, u)
+				i += 3
+				continue
+			}
+		}
+		if c >= 0x10000 {
+			hi, lo := utf16.EncodeRune(c)
+			r = append(r, uint16(hi), uint16(lo))
+		} else {
+			r = append(r, uint16(c))
+		}
+		i += size
+	}
+	return r
+}
+
+// decodeSurrogate decode 3 bytes WTF-8 sequence of surrogate code point
+func decodeSurrogate(s string) (uint16, bool) {
+	if len(s) < 3 || s[0] != 0xed || s[1] < 0xa0 || s[1] > 0xbf || s[2] < 0x80 || s[2] > 0xbf {
+		return 0, false
+	}
+	return 0xd000 | uint16(s[1]&0x3f)<<6 | uint16(s[2]&0x3f), true
+}
+
+// String return go string; lone surrogates are encoded in WTF-8
+func (s JSString) String() string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		u := rune(s[i])
+		if isHigh(s[i]) && i+1 < len(s) && isLow(s[i+1]) {
+			b = appendRune(b, utf16.DecodeRune(u, rune(s[i+1])))
+			i++
+			continue
+		}
+		if utf16.IsSurrogate(u) {
+			b = append(b, 0xe0|byte(u>>12), 0x80|byte(u>>6)&0x3f, 0x80|byte(u)&0x3f)
+			continue
+		}
+		b = appendRune(b, u)
+	}
+	return string(b)
+}
+
+func appendRune(b []byte, r rune) []byte {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return append(b, buf[:n]...)
+}
+
+func isHigh(u uint16) bool {
+	return u >= 0xd800 && u <= 0xdbff
+}
+
+func isLow(u uint16) bool {
+	return u >= 0xdc00 && u <= 0xdfff
+}
+
+// Length return count of code units, like js length
+func (s JSString) Length() int {
+	return len(s)
+}
+
+// CharCodeAt return code unit at index, like js charCodeAt
+// 	ok is false for index out of range
+func (s JSString) CharCodeAt(index int) (uint16, bool) {
+	if index < 0 || index >= len(s) {
+		return 0, false
+	}
+	return s[index], true
+}
+
+// CodePointAt return code point starting at index, like js codePointAt
+// 	lone surrogate is returned as is; ok is false for index out of range
+func (s JSString) CodePointAt(index int) (rune, bool) {
+	if index < 0 || index >= len(s) {
+		return 0, false
+	}
+	if isHigh(s[index]) && index+1 < len(s) && isLow(s[index+1]) {
+		return utf16.DecodeRune(rune(s[index]), rune(s[index+1])), true
+	}
+	return rune(s[index]), true
+}
+
+// At return code unit at index as string, like js at
+// 	if index < 0, then count from end; ok is false for index out of range
+func (s JSString) At(index int) (JSString, bool) {
+	if index < 0 {
+		index += len(s)
+	}
+	if index < 0 || index >= len(s) {
+		return nil, false
+	}
+	return s[index : index+1], true
+}
+
+// IsWellFormed check is s without lone surrogates, like js isWellFormed
+func (s JSString) IsWellFormed() bool {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case isHigh(s[i]) && i+1 < len(s) && isLow(s[i+1]):
+			i++
+		case utf16.IsSurrogate(rune(s[i])):
+			return false
+		}
+	}
+	return true
+}
+
+// ToWellFormed return copy of s with lone surrogates replaced by U+FFFD, like js toWellFormed
+func (s JSString) ToWellFormed() JSString {
+	r := make(JSString, len(s))
+	copy(r, s)
+	for i := 0; i < len(r); i++ {
+		switch {
+		case isHigh(r[i]) && i+1 < len(r) && isLow(r[i+1]):
+			i++
+		case utf16.IsSurrogate(rune(r[i])):
+			r[i] = utf8.RuneError
+		}
+	}
+	return r
+}
+
+// Index return index of first sub occurrence starting from index from or -1
+func (s JSString) Index(sub JSString, from int) int {
+	if from < 0 {
+		from = 0
+	}
+	for i := from; i+len(sub) <= len(s); i++ {
+		if Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Equal check are a & b same code units
+func Equal(a, b JSString) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare compare a & b by code units, like js < on strings
+// 	return -1 if a < b, 1 if a > b, 0 if equal
+func Compare(a, b JSString) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// CompareStrings compare go strings by UTF-16 code units, like js < on strings
+func CompareStrings(a, b string) int {
+	return Compare(New(a), New(b))
+}
@@ -0,0 +1,107 @@
+package jsstring
+
+import (
+	"reflect"
+	"testing"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		incoming    string
+		want        JSString
+		description string
+	}{
+		{"abc", JSString{'a', 'b', 'c'}, "ascii"},
+		{"😀", JSString{0xd83d, 0xde00}, "surrogate pair"},
+		{"é", JSString{0xe9}, "bmp"},
+		{"\xed\xa0\xbd", JSString{0xd83d}, "WTF-8 lone high surrogate"},
+		{"a\xed\xb8\x80", JSString{'a', 0xde00}, "WTF-8 lone low surrogate"},
+		{"\xff", JSString{0xfffd}, "invalid byte"},
+		{"", JSString{}, "empty"},
+	}
+
+	for _, tt := range tests {
+		got := New(tt.incoming)
+		TestLog("New", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		incoming    JSString
+		want        string
+		description string
+	}{
+		{JSString{'a', 0xd83d, 0xde00}, "a😀", "pair to utf-8"},
+		{JSString{0xd83d}, "\xed\xa0\xbd", "lone surrogate to WTF-8"},
+		{JSString{0xde00, 0xd83d}, "\xed\xb8\x80\xed\xa0\xbd", "reversed pair is not joined"},
+	}
+
+	for _, tt := range tests {
+		got := tt.incoming.String()
+		TestLog("String", t, tt.incoming, got, tt.want, tt.description)
+		TestLog("String", t, tt.incoming, New(got), tt.incoming, tt.description+" round trip")
+	}
+
+	// halves of pair joined back as go strings make full code point
+	hi, lo := JSString{0xd83d}.String(), JSString{0xde00}.String()
+	TestLog("String", t, "halves", New(hi+lo).String(), "😀", "concatenated halves")
+}
+
+func TestWellFormed(t *testing.T) {
+	tests := []struct {
+		incoming    JSString
+		well        bool
+		want        JSString
+		description string
+	}{
+		{New("a😀"), true, New("a😀"), "well formed"},
+		{JSString{'a', 0xd83d}, false, JSString{'a', 0xfffd}, "lone high"},
+		{JSString{0xde00, 'a'}, false, JSString{0xfffd, 'a'}, "lone low"},
+	}
+
+	for _, tt := range tests {
+		TestLog("IsWellFormed", t, tt.incoming, tt.incoming.IsWellFormed(), tt.well, tt.description)
+		TestLog("ToWellFormed", t, tt.incoming, tt.incoming.ToWellFormed(), tt.want, tt.description)
+	}
+}
+
+func TestIndexing(t *testing.T) {
+	s := New("a😀")
+	TestLog("Length", t, s, s.Length(), 3, "length in code units")
+	c, ok := s.CharCodeAt(2)
+	TestLog("CharCodeAt", t, 2, []interface{}{c, ok}, []interface{}{uint16(0xde00), true}, "low surrogate")
+	r, _ := s.CodePointAt(1)
+	TestLog("CodePointAt", t, 1, r, '😀', "code point")
+	at, ok := s.At(-1)
+	TestLog("At", t, -1, []interface{}{at, ok}, []interface{}{JSString{0xde00}, true}, "at from end")
+	TestLog("Index", t, "😀", s.Index(New("😀"), 0), 1, "index")
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b        string
+		want        int
+		description string
+	}{
+		{"a", "b", -1, "ascii"},
+		{"B", "a", -1, "upper before lower"},
+		{"ab", "a", 1, "longer"},
+		{"｡", "😀", 1, "code units, not code points"},
+		{"x", "x", 0, "equal"},
+	}
+
+	for _, tt := range tests {
+		got := CompareStrings(tt.a, tt.b)
+		TestLog("Compare", t, tt.a+" "+tt.b, got, tt.want, tt.description)
+	}
+}
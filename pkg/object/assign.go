@@ -0,0 +1,94 @@
+package object
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// Assign copy own properties of sources to target & return target, like js Object.assign
+// 	target is map with string keys or pointer to struct; sources are any objects
+// 	properties without struct field in target are skipped;
+// 	value is converted to field type if possible, like float64 to int
+// 	return TypeError for other targets or values of wrong type
+func Assign(target interface{}, sources ...interface{}) (interface{}, error) {
+	if target == nil {
+		return nil, &array.TypeError{Message: "Cannot convert undefined or null to object"}
+	}
+	v := reflect.ValueOf(target)
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String && !v.IsNil():
+		for _, src := range sources {
+			for _, p := range properties(src) {
+				value, err := convert(p.value, v.Type().Elem(), p.key)
+				if err != nil {
+					return target, err
+				}
+				v.SetMapIndex(reflect.ValueOf(p.key).Convert(v.Type().Key()), value)
+			}
+		}
+		return target, nil
+	case v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct:
+		s := v.Elem()
+		names := map[string][]int{}
+		for _, f := range fields(s.Type()) {
+			names[f.name] = f.index
+		}
+		for _, src := range sources {
+			for _, p := range properties(src) {
+				index, ok := names[p.key]
+				if !ok {
+					continue
+				}
+				fv, ok := settableField(s, index)
+				if !ok {
+					continue
+				}
+				value, err := convert(p.value, fv.Type(), p.key)
+				if err != nil {
+					return target, err
+				}
+				fv.Set(value)
+			}
+		}
+		return target, nil
+	}
+	return target, &array.TypeError{Message: fmt.Sprintf("Cannot assign to %T", target)}
+}
+
+// settableField return field of struct; nil embedded pointers are allocated
+func settableField(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, v.CanSet()
+}
+
+// convert return value of type t; nil is zero value, numbers are converted between go types
+func convert(value interface{}, t reflect.Type, key string) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(t), nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+	if isNumber(v.Kind()) && isNumber(t.Kind()) {
+		return v.Convert(t), nil
+	}
+	return reflect.Value{}, &array.TypeError{Message: "Cannot assign " + v.Type().String() + " to property '" + key + "' of type " + t.String()}
+}
+
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
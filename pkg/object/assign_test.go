@@ -0,0 +1,39 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestObjectAssign(t *testing.T) {
+	target := map[string]interface{}{"a": 1}
+	got, err := Assign(target, map[string]int{"b": 2}, user{Name: "x"})
+	TestLog("Assign", t, "map", got, map[string]interface{}{"a": 1, "b": 2, "name": "x", "age": 0, "Email": ""}, "map target")
+	TestLog("Assign", t, "map", err, nil, "no error")
+
+	u := &user{Name: "old", Email: "e"}
+	_, err = Assign(u, map[string]interface{}{"name": "new", "age": 30.0, "unknown": 1, "Secret": "s"})
+	TestLog("Assign", t, "struct", *u, user{Name: "new", Age: 30, Email: "e"}, "struct target, float converted to int")
+	TestLog("Assign", t, "struct", err, nil, "no error")
+
+	p := &post{}
+	Assign(p, map[string]interface{}{"Note": "n", "id": 7})
+	TestLog("Assign", t, "embedded", p.Extra.Note, "n", "nil embedded pointer allocated")
+	TestLog("Assign", t, "embedded", p.ID, 7, "embedded field")
+
+	_, err = Assign(u, map[string]interface{}{"name": 1})
+	_, ok := err.(*array.TypeError)
+	TestLog("Assign", t, "wrong type", ok, true, "value of wrong type")
+
+	_, err = Assign(user{}, nil)
+	_, ok = err.(*array.TypeError)
+	TestLog("Assign", t, "not pointer", ok, true, "struct target must be pointer")
+
+	got, err = Assign(nil, map[string]interface{}{"a": 1})
+	TestLog("Assign", t, "nil", got, nil, "nil target")
+	TestLog("Assign", t, "nil", err, error(&array.TypeError{Message: "Cannot convert undefined or null to object"}), "nil target error")
+
+	_, err = Assign((*user)(nil), nil)
+	TestLog("Assign", t, "nil pointer", err, error(&array.TypeError{Message: "Cannot assign to *object.user"}), "nil pointer target error")
+}
@@ -0,0 +1,117 @@
+package object
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// field is struct field visible as property
+type field struct {
+	name  string
+	index []int
+}
+
+var fieldsCache sync.Map
+
+// fields return properties of struct type: exported fields named by json tag,
+// 	fields of embedded structs are promoted & shadowed by shallower fields, like in encoding/json
+// 	fields are in declaration order, "-" tag skip field
+func fields(t reflect.Type) []field {
+	if f, ok := fieldsCache.Load(t); ok {
+		return f.([]field)
+	}
+
+	type candidate struct {
+		field
+		depth  int
+		tagged bool
+	}
+	var all []candidate
+	var walk func(t reflect.Type, index []int, depth int, seen map[reflect.Type]bool)
+	walk = func(t reflect.Type, index []int, depth int, seen map[reflect.Type]bool) {
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			fi := append(append([]int{}, index...), i)
+
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+				walk(ft, fi, depth+1, seen)
+				continue
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			tagged := name != ""
+			if !tagged {
+				name = sf.Name
+			}
+			all = append(all, candidate{field: field{name: name, index: fi}, depth: depth, tagged: tagged})
+		}
+	}
+	walk(t, nil, 0, map[reflect.Type]bool{})
+
+	// dominant field of each name: shallowest, then tagged; ambiguous names are dropped
+	byName := map[string][]candidate{}
+	for _, c := range all {
+		byName[c.name] = append(byName[c.name], c)
+	}
+	r := []field{}
+	for _, c := range all {
+		cs := byName[c.name]
+		best := cs[0]
+		ambiguous := false
+		for _, o := range cs[1:] {
+			switch {
+			case o.depth < best.depth || o.depth == best.depth && o.tagged && !best.tagged:
+				best, ambiguous = o, false
+			case o.depth == best.depth && o.tagged == best.tagged:
+				ambiguous = true
+			}
+		}
+		if !ambiguous && reflect.DeepEqual(best.index, c.index) {
+			r = append(r, c.field)
+		}
+	}
+	sort.SliceStable(r, func(i, j int) bool { return lessIndex(r[i].index, r[j].index) })
+
+	fieldsCache.Store(t, r)
+	return r
+}
+
+func lessIndex(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// fieldValue return value of field or false if field is in nil embedded pointer
+func fieldValue(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
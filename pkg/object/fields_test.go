@@ -0,0 +1,58 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+type base struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type Extra struct {
+	Note string
+}
+
+type post struct {
+	Title string `json:"title"`
+	base
+	*Extra
+	Name string `json:"name"`
+}
+
+type ambiguous struct {
+	base
+	other
+	code
+}
+
+type other struct {
+	Code int
+}
+
+type code struct {
+	Code string
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        []string
+		description string
+	}{
+		{incoming: post{}, want: []string{"title", "id", "Note", "name"}, description: "embedded fields promoted & shadowed"},
+		{incoming: ambiguous{}, want: []string{"id", "name"}, description: "ambiguous names dropped"},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, f := range fields(reflect.TypeOf(tt.incoming)) {
+			got = append(got, f.name)
+		}
+		TestLog("fields", t, tt.incoming, got, tt.want, tt.description)
+	}
+
+	p := post{Title: "t", base: base{ID: 1, Name: "hidden"}, Name: "n"}
+	TestLog("Values", t, p, Values(p).Items, Values([]interface{}{"t", 1, "n"}).Items, "nil embedded pointer is skipped")
+}
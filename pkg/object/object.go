@@ -0,0 +1,177 @@
+package object
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// object can be map with string or integer keys, struct or pointer to struct,
+// Array, slice, go array or string
+// properties of struct are its exported fields named by json tags, see encoding/json
+// key order is like in js: integer keys ascending first, then map keys sorted, struct fields in declaration order
+
+// property is own property of object
+type property struct {
+	key   string
+	value interface{}
+}
+
+// properties return own enumerable properties of o in js order
+// 	nil & unsupported values have no properties
+func properties(o interface{}) []property {
+	switch o := o.(type) {
+	case nil:
+		return nil
+	case *array.Array:
+		r := make([]property, len(o.Items))
+		for i, v := range o.Items {
+			r[i] = property{key: strconv.Itoa(i), value: v.Data}
+		}
+		return r
+	case string:
+		u := jsstring.New(o)
+		r := make([]property, len(u))
+		for i := range u {
+			r[i] = property{key: strconv.Itoa(i), value: u[i : i+1].String()}
+		}
+		return r
+	}
+
+	v := reflect.ValueOf(o)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		r := make([]property, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k := iter.Key()
+			switch k.Kind() {
+			case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				r = append(r, property{key: conv.ToString(k.Interface()), value: iter.Value().Interface()})
+			}
+		}
+		sort.Slice(r, func(i, j int) bool { return lessKey(r[i].key, r[j].key) })
+		return r
+	case reflect.Struct:
+		fs := fields(v.Type())
+		r := make([]property, 0, len(fs))
+		for _, f := range fs {
+			if fv, ok := fieldValue(v, f.index); ok {
+				r = append(r, property{key: f.name, value: fv.Interface()})
+			}
+		}
+		return r
+	case reflect.Slice, reflect.Array:
+		r := make([]property, v.Len())
+		for i := range r {
+			r[i] = property{key: strconv.Itoa(i), value: v.Index(i).Interface()}
+		}
+		return r
+	}
+	return nil
+}
+
+// arrayIndex parse canonical array index key, like "0" or "12", but not "01"
+func arrayIndex(key string) (uint64, bool) {
+	n, err := strconv.ParseUint(key, 10, 32)
+	if err != nil || n == 1<<32-1 || strconv.FormatUint(n, 10) != key {
+		return 0, false
+	}
+	return n, true
+}
+
+// lessKey order keys like js: integer keys ascending, then other keys in UTF-16 order
+func lessKey(a, b string) bool {
+	ia, aok := arrayIndex(a)
+	ib, bok := arrayIndex(b)
+	switch {
+	case aok && bok:
+		return ia < ib
+	case aok != bok:
+		return aok
+	}
+	return jsstring.CompareStrings(a, b) < 0
+}
+
+// Keys return Array of own property names of o, like js Object.keys
+func Keys(o interface{}) *array.Array {
+	r := array.NewArray()
+	for _, p := range properties(o) {
+		r.Push(p.key)
+	}
+	return r
+}
+
+// Values return Array of own property values of o, like js Object.values
+func Values(o interface{}) *array.Array {
+	r := array.NewArray()
+	for _, p := range properties(o) {
+		r.Push(p.value)
+	}
+	return r
+}
+
+// Entries return Array of [key, value] Arrays of o, like js Object.entries
+func Entries(o interface{}) *array.Array {
+	r := array.NewArray()
+	for _, p := range properties(o) {
+		r.Push(array.MakeArray(p.key, p.value))
+	}
+	return r
+}
+
+// HasOwn check has o own property key, like js Object.hasOwn
+func HasOwn(o interface{}, key string) bool {
+	for _, p := range properties(o) {
+		if p.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// FromEntries return map of entries, like js Object.fromEntries
+// 	entries are [key, value] Arrays or jsmap.Entry, like Entries result or jsmap.Map EntriesArray
+// 	keys are converted to strings
+// 	return TypeError for other entries
+func FromEntries(entries *array.Array) (map[string]interface{}, error) {
+	r := map[string]interface{}{}
+	for _, v := range entries.Items {
+		// missing key of short entry is undefined, like in js
+		key, value := "undefined", interface{}(nil)
+		switch e := v.Data.(type) {
+		case *array.Array:
+			if len(e.Items) > 0 {
+				key = conv.ToString(e.Items[0].Data)
+			}
+			if len(e.Items) > 1 {
+				value = e.Items[1].Data
+			}
+		case jsmap.Entry:
+			key, value = conv.ToString(e.Key), e.Value
+		default:
+			return nil, &array.TypeError{Message: "Iterator value " + conv.ToString(v.Data) + " is not an entry object"}
+		}
+		r[key] = value
+	}
+	return r, nil
+}
+
+// GroupBy return elements of items grouped by callback key, like js Object.groupBy
+func GroupBy(items *array.Array, callback func(value array.ArrayItem, index int) interface{}) map[string]*array.Array {
+	return items.GroupBy(func(value array.ArrayItem, index int, _ *array.Array) interface{} {
+		return callback(value, index)
+	})
+}
@@ -0,0 +1,137 @@
+package object
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+type user struct {
+	Name    string `json:"name"`
+	Age     int    `json:"age,omitempty"`
+	Secret  string `json:"-"`
+	private int
+	Email   string
+}
+
+func TestObjectKeys(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		want        *array.Array
+		description string
+	}{
+		{
+			incoming:    map[string]interface{}{"b": 1, "10": 2, "a": 3, "2": 4, "01": 5},
+			want:        array.MakeArray("2", "10", "01", "a", "b"),
+			description: "integer keys first",
+		},
+		{
+			incoming:    user{Name: "x"},
+			want:        array.MakeArray("name", "age", "Email"),
+			description: "struct json names in field order",
+		},
+		{
+			incoming:    &user{},
+			want:        array.MakeArray("name", "age", "Email"),
+			description: "pointer to struct",
+		},
+		{
+			incoming:    map[int]bool{3: true, 1: false},
+			want:        array.MakeArray("1", "3"),
+			description: "integer map keys",
+		},
+		{
+			incoming:    array.MakeArray("a", "b"),
+			want:        array.MakeArray("0", "1"),
+			description: "Array indexes",
+		},
+		{
+			incoming:    "ab",
+			want:        array.MakeArray("0", "1"),
+			description: "string indexes",
+		},
+		{
+			incoming:    nil,
+			want:        array.NewArray(),
+			description: "nil",
+		},
+		{
+			incoming:    42,
+			want:        array.NewArray(),
+			description: "primitive",
+		},
+	}
+
+	for _, tt := range tests {
+		TestLog("Keys", t, tt.incoming, Keys(tt.incoming), tt.want, tt.description)
+	}
+}
+
+func TestObjectValuesEntries(t *testing.T) {
+	u := user{Name: "x", Age: 3, Secret: "s", Email: "e"}
+	TestLog("Values", t, u, Values(u), array.MakeArray("x", 3, "e"), "struct values")
+	TestLog("Entries", t, u, Entries(u), array.MakeArray(
+		array.MakeArray("name", "x"),
+		array.MakeArray("age", 3),
+		array.MakeArray("Email", "e"),
+	), "struct entries")
+	TestLog("Values", t, "\U0001F600", len(Values("\U0001F600").Items), 2, "string code units")
+}
+
+func TestObjectHasOwn(t *testing.T) {
+	tests := []struct {
+		incoming    interface{}
+		key         string
+		want        bool
+		description string
+	}{
+		{incoming: map[string]int{"a": 0}, key: "a", want: true, description: "map key"},
+		{incoming: map[string]int{"a": 0}, key: "b", want: false, description: "missing map key"},
+		{incoming: user{}, key: "name", want: true, description: "json name"},
+		{incoming: user{}, key: "Name", want: false, description: "go name of tagged field"},
+		{incoming: user{}, key: "Secret", want: false, description: "skipped field"},
+		{incoming: user{}, key: "private", want: false, description: "unexported field"},
+	}
+
+	for _, tt := range tests {
+		TestLog("HasOwn", t, tt.key, HasOwn(tt.incoming, tt.key), tt.want, tt.description)
+	}
+}
+
+func TestObjectFromEntries(t *testing.T) {
+	got, err := FromEntries(Entries(map[string]interface{}{"a": 1, "b": "x"}))
+	TestLog("FromEntries", t, "entries", got, map[string]interface{}{"a": 1, "b": "x"}, "round trip")
+	TestLog("FromEntries", t, "entries", err, nil, "no error")
+
+	m := jsmap.New(jsmap.Entry{Key: 1, Value: true}, jsmap.Entry{Key: nil, Value: 2})
+	got, _ = FromEntries(m.EntriesArray())
+	TestLog("FromEntries", t, "map", got, map[string]interface{}{"1": true, "null": 2}, "keys are strings")
+
+	got, _ = FromEntries(array.MakeArray(array.MakeArray(), jsmap.Entry{Key: "k", Value: "v"}))
+	TestLog("FromEntries", t, "short", got, map[string]interface{}{"undefined": nil, "k": "v"}, "short entry & jsmap.Entry")
+
+	_, err = FromEntries(array.MakeArray("ab"))
+	_, ok := err.(*array.TypeError)
+	TestLog("FromEntries", t, "ab", ok, true, "not entry")
+}
+
+func TestObjectGroupBy(t *testing.T) {
+	got := GroupBy(array.MakeArray(1, 2, 3), func(v array.ArrayItem, _ int) interface{} {
+		return v.Data.(int)%2 == 0
+	})
+	TestLog("GroupBy", t, "1,2,3", got, map[string]*array.Array{
+		"false": array.MakeArray(1, 3),
+		"true":  array.MakeArray(2),
+	}, "groups")
+}
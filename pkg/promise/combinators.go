@@ -0,0 +1,125 @@
+package promise
+
+import (
+	"sync"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// statuses of Settled
+const (
+	Fulfilled = "fulfilled"
+	Rejected  = "rejected"
+)
+
+// Settled is outcome of promise, like js {status, value, reason} of Promise.allSettled
+type Settled struct {
+	Status string
+	Value  interface{}
+	Reason error
+}
+
+// All return Promise fulfilled with Array of values of all promises in order, like js Promise.all
+// 	elements of promises which aren't Promise are resolved by Resolve
+// 	rejected with reason of first rejected promise
+func All(promises *array.Array) *Promise {
+	return collect(promises, func(v interface{}, err error) (interface{}, bool) {
+		return v, err == nil
+	})
+}
+
+// AllSettled return Promise fulfilled with Array of Settled of all promises in order, like js Promise.allSettled
+func AllSettled(promises *array.Array) *Promise {
+	return collect(promises, func(v interface{}, err error) (interface{}, bool) {
+		if err != nil {
+			return Settled{Status: Rejected, Reason: err}, true
+		}
+		return Settled{Status: Fulfilled, Value: v}, true
+	})
+}
+
+// Race return Promise settled like first settled promise, like js Promise.race
+// 	Promise of empty Array stays pending forever
+func Race(promises *array.Array) *Promise {
+	p, resolve, reject := WithResolvers()
+	for _, item := range promises.Items {
+		Resolve(item.Data).react(func(v interface{}, err error) {
+			if err != nil {
+				reject(err)
+				return
+			}
+			resolve(v)
+		})
+	}
+	return p
+}
+
+// Any return Promise fulfilled with value of first fulfilled promise, like js Promise.any
+// 	if all promises are rejected, it's rejected with AggregateError of their reasons in order
+func Any(promises *array.Array) *Promise {
+	p, resolve, reject := WithResolvers()
+	items := promises.Items
+	errs := make([]error, len(items))
+	rejectAll := func() {
+		reject(&AggregateError{Message: "All promises were rejected", Errors: errs})
+	}
+	if len(items) == 0 {
+		rejectAll()
+		return p
+	}
+
+	var mu sync.Mutex
+	remaining := len(items)
+	for i, item := range items {
+		i := i
+		Resolve(item.Data).react(func(v interface{}, err error) {
+			if err == nil {
+				resolve(v)
+				return
+			}
+			mu.Lock()
+			errs[i] = err
+			remaining--
+			last := remaining == 0
+			mu.Unlock()
+			if last {
+				rejectAll()
+			}
+		})
+	}
+	return p
+}
+
+// collect return Promise fulfilled with Array of results of fn for each settled promise in order
+// 	if fn return not ok, returned promise is rejected with reason of settled promise
+func collect(promises *array.Array, fn func(v interface{}, err error) (interface{}, bool)) *Promise {
+	p, resolve, reject := WithResolvers()
+	items := promises.Items
+	values := make([]interface{}, len(items))
+	if len(items) == 0 {
+		resolve(array.NewArray())
+		return p
+	}
+
+	var mu sync.Mutex
+	remaining := len(items)
+	for i, item := range items {
+		i := i
+		Resolve(item.Data).react(func(v interface{}, err error) {
+			r, ok := fn(v, err)
+			if !ok {
+				reject(err)
+				return
+			}
+			mu.Lock()
+			values[i] = r
+			remaining--
+			last := remaining == 0
+			mu.Unlock()
+			if last {
+				resolve(array.MakeArray(values...))
+			}
+		})
+	}
+	return p
+}
@@ -0,0 +1,146 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// after return Promise settled with v or err after d
+func after(d time.Duration, v interface{}, err error) *Promise {
+	return New(func(resolve func(v interface{}), reject func(err error)) {
+		time.AfterFunc(d, func() {
+			if err != nil {
+				reject(err)
+				return
+			}
+			resolve(v)
+		})
+	})
+}
+
+// never return Promise which is never settled
+func never() *Promise {
+	p, _, _ := WithResolvers()
+	return p
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		incoming *array.Array
+		value    interface{}
+		err      error
+		descr    string
+	}{
+		{array.NewArray(), array.NewArray(), nil, "empty"},
+		{array.MakeArray(after(20*time.Millisecond, 1, nil), 2, Resolve(3)), array.MakeArray(1, 2, 3), nil, "values in order"},
+		{array.MakeArray(after(20*time.Millisecond, 1, nil), thenable{value: 2}, future{value: 3}), array.MakeArray(1, 2, 3), nil, "thenable & future"},
+		{array.MakeArray(Resolve(1), after(5*time.Millisecond, nil, errTest), never()), nil, errTest, "first rejection"},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, All(v.incoming))
+		TestLog("All", t, v.descr, got, v.value, v.descr)
+		TestLog("All", t, v.descr, err, v.err, v.descr)
+	}
+}
+
+func TestAllSettled(t *testing.T) {
+	tests := []struct {
+		incoming *array.Array
+		value    interface{}
+		descr    string
+	}{
+		{array.NewArray(), array.NewArray(), "empty"},
+		{
+			array.MakeArray(after(10*time.Millisecond, nil, errTest), 2),
+			array.MakeArray(Settled{Status: Rejected, Reason: errTest}, Settled{Status: Fulfilled, Value: 2}),
+			"fulfilled & rejected",
+		},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, AllSettled(v.incoming))
+		TestLog("AllSettled", t, v.descr, got, v.value, v.descr)
+		TestLog("AllSettled", t, v.descr, err, nil, v.descr)
+	}
+}
+
+func TestRace(t *testing.T) {
+	tests := []struct {
+		incoming *array.Array
+		value    interface{}
+		err      error
+		descr    string
+	}{
+		{array.MakeArray(after(50*time.Millisecond, 1, nil), after(5*time.Millisecond, 2, nil)), 2, nil, "first fulfilled"},
+		{array.MakeArray(after(50*time.Millisecond, 1, nil), after(5*time.Millisecond, nil, errTest)), nil, errTest, "first rejected"},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, Race(v.incoming))
+		TestLog("Race", t, v.descr, got, v.value, v.descr)
+		TestLog("Race", t, v.descr, err, v.err, v.descr)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := Race(array.MakeArray(never())).Await(ctx)
+	TestLog("Race", t, "pending", err, context.DeadlineExceeded, "pending stays pending")
+	_, err = Race(array.NewArray()).Await(ctx)
+	TestLog("Race", t, "empty", err, context.DeadlineExceeded, "empty stays pending")
+}
+
+func TestAny(t *testing.T) {
+	tests := []struct {
+		incoming *array.Array
+		value    interface{}
+		err      error
+		descr    string
+	}{
+		{array.MakeArray(Reject(errTest), after(20*time.Millisecond, 2, nil), after(50*time.Millisecond, 3, nil)), 2, nil, "first fulfilled"},
+		{
+			array.MakeArray(after(20*time.Millisecond, nil, errTest), Reject(context.Canceled)), nil,
+			&AggregateError{Message: "All promises were rejected", Errors: []error{errTest, context.Canceled}},
+			"all rejected",
+		},
+		{array.NewArray(), nil, &AggregateError{Message: "All promises were rejected", Errors: []error{}}, "empty"},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, Any(v.incoming))
+		TestLog("Any", t, v.descr, got, v.value, v.descr)
+		if v.err == nil {
+			TestLog("Any", t, v.descr, err, nil, v.descr)
+			continue
+		}
+		TestLog("Any", t, v.descr, err, error(v.err), v.descr)
+	}
+
+	_, err := await(t, Any(array.MakeArray(Reject(errTest))))
+	TestLog("Any", t, "errors.Is", errors.Is(err, errTest), true, "aggregated errors are unwrapped")
+	TestLog("Any", t, "Error", err.Error(), "AggregateError: All promises were rejected", "message")
+}
+
+func TestWithResolvers(t *testing.T) {
+	p, resolve, reject := WithResolvers()
+	go resolve(1)
+	got, err := await(t, p)
+	TestLog("WithResolvers", t, "resolve", got, 1, "resolve")
+	TestLog("WithResolvers", t, "resolve", err, nil, "resolve")
+
+	reject(errTest)
+	got, _ = await(t, p)
+	TestLog("WithResolvers", t, "reject", got, 1, "reject after resolve is ignored")
+
+	var f array.Future = p
+	got, _ = f.Await(context.Background())
+	TestLog("WithResolvers", t, "future", got, 1, "promise is array.Future")
+
+	arr, err := array.FromAsync(context.Background(), array.MakeArray(Resolve("a"), after(5*time.Millisecond, "b", nil)))
+	TestLog("FromAsync", t, "promises", arr, array.MakeArray("a", "b"), "promises are awaited by array.FromAsync")
+	TestLog("FromAsync", t, "promises", err, nil, "promises are awaited by array.FromAsync")
+}
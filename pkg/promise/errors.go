@@ -0,0 +1,16 @@
+package promise
+
+// AggregateError is error of several errors, like js AggregateError
+type AggregateError struct {
+	Message string
+	Errors  []error
+}
+
+func (e *AggregateError) Error() string {
+	return "AggregateError: " + e.Message
+}
+
+// Unwrap return aggregated errors, so errors.Is & errors.As check each of them
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
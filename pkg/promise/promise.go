@@ -0,0 +1,321 @@
+package promise
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+type state int
+
+const (
+	pending state = iota
+	fulfilled
+	rejected
+)
+
+// Promise is eventual result of async operation, like js Promise
+// 	handlers of settled promise are called in order of registration in separate goroutine
+// 	Promise is safe for concurrent use & implements array.Future
+type Promise struct {
+	mu        sync.Mutex
+	state     state
+	value     interface{}
+	reason    error
+	handled   bool
+	reactions []func()
+	draining  bool
+	done      chan struct{}
+}
+
+// Thenable is value with js then method, it's assimilated when promise is resolved with it
+type Thenable interface {
+	Then(resolve func(v interface{}), reject func(err error))
+}
+
+var _ array.Future = (*Promise)(nil)
+
+// New return new Promise settled by executor, like js new Promise
+// 	executor is called synchronously; panic with error rejects promise
+// 	only first call of resolve or reject has effect, resolve assimilates promises & thenables
+func New(executor func(resolve func(v interface{}), reject func(err error))) (p *Promise) {
+	p = newPromise()
+	resolve, reject := p.resolvers()
+	defer rejectPanic(reject)
+	executor(resolve, reject)
+	return p
+}
+
+// WithResolvers return new pending Promise with its resolving functions, like js Promise.withResolvers
+func WithResolvers() (p *Promise, resolve func(v interface{}), reject func(err error)) {
+	p = newPromise()
+	resolve, reject = p.resolvers()
+	return p, resolve, reject
+}
+
+// Resolve return Promise resolved with v, like js Promise.resolve
+// 	Promise is returned as is, thenables & array.Future are assimilated
+func Resolve(v interface{}) *Promise {
+	if p, ok := v.(*Promise); ok {
+		return p
+	}
+	p := newPromise()
+	resolve, _ := p.resolvers()
+	resolve(v)
+	return p
+}
+
+// Reject return Promise rejected with err, like js Promise.reject
+func Reject(err error) *Promise {
+	p := newPromise()
+	_, reject := p.resolvers()
+	reject(err)
+	return p
+}
+
+func newPromise() *Promise {
+	return &Promise{done: make(chan struct{})}
+}
+
+// Then return Promise resolved with result of handler, like js then
+// 	onFulfilled is called with value, onRejected with reason; nil handler pass result through
+// 	handler error or panic rejects returned promise; panic of non-error value is wrapped in error
+func (p *Promise) Then(onFulfilled func(v interface{}) (interface{}, error), onRejected func(err error) (interface{}, error)) *Promise {
+	r := newPromise()
+	resolve, reject := r.resolvers()
+	p.react(func(v interface{}, err error) {
+		switch {
+		case err == nil && onFulfilled == nil:
+			resolve(v)
+		case err != nil && onRejected == nil:
+			reject(err)
+		case err == nil:
+			call(resolve, reject, func() (interface{}, error) { return onFulfilled(v) })
+		default:
+			call(resolve, reject, func() (interface{}, error) { return onRejected(err) })
+		}
+	})
+	return r
+}
+
+// Catch is like Then with only onRejected handler, like js catch
+func (p *Promise) Catch(onRejected func(err error) (interface{}, error)) *Promise {
+	return p.Then(nil, onRejected)
+}
+
+// Finally return Promise settled like p after onFinally is called, like js finally
+// 	error of onFinally rejects returned promise instead
+func (p *Promise) Finally(onFinally func() error) *Promise {
+	r := newPromise()
+	resolve, reject := r.resolvers()
+	p.react(func(v interface{}, err error) {
+		call(resolve, reject, func() (interface{}, error) {
+			if ferr := onFinally(); ferr != nil {
+				return nil, ferr
+			}
+			return v, err
+		})
+	})
+	return r
+}
+
+// Await wait until promise is settled & return its value or reason, like js await
+// 	on ctx cancel return ctx error, promise isn't affected
+func (p *Promise) Await(ctx context.Context) (interface{}, error) {
+	p.mu.Lock()
+	p.handled = true
+	p.mu.Unlock()
+
+	select {
+	case <-p.done:
+		return p.value, p.reason
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// resolvers return resolving functions of promise, only first call of any of them has effect
+func (p *Promise) resolvers() (resolve func(v interface{}), reject func(err error)) {
+	var once sync.Once
+	resolve = func(v interface{}) {
+		once.Do(func() { p.resolve(v) })
+	}
+	reject = func(err error) {
+		once.Do(func() { p.reject(err) })
+	}
+	return resolve, reject
+}
+
+// resolve fulfill promise with v or make it follow v, like js promise resolve functions
+func (p *Promise) resolve(v interface{}) {
+	switch t := v.(type) {
+	case *Promise:
+		if t == p {
+			p.reject(&array.TypeError{Message: "Chaining cycle detected for promise #<Promise>"})
+			return
+		}
+		t.react(func(v interface{}, err error) {
+			if err != nil {
+				p.reject(err)
+				return
+			}
+			p.settle(fulfilled, v, nil)
+		})
+	case Thenable:
+		resolve, reject := p.resolvers()
+		go func() {
+			defer rejectThrown(reject)
+			t.Then(resolve, reject)
+		}()
+	case array.Future:
+		go func() {
+			v, err := t.Await(context.Background())
+			if err != nil {
+				p.reject(err)
+				return
+			}
+			p.resolve(v)
+		}()
+	default:
+		p.settle(fulfilled, v, nil)
+	}
+}
+
+// reject reject promise with err; nil err is replaced by array.TypeError
+func (p *Promise) reject(err error) {
+	if err == nil {
+		err = &array.TypeError{Message: "Promise rejected with nil reason"}
+	}
+	p.settle(rejected, nil, err)
+}
+
+func (p *Promise) settle(st state, v interface{}, err error) {
+	p.mu.Lock()
+	if p.state != pending {
+		p.mu.Unlock()
+		return
+	}
+	p.state, p.value, p.reason = st, v, err
+	close(p.done)
+	unhandled := st == rejected && !p.handled
+	start := p.start()
+	p.mu.Unlock()
+
+	if unhandled {
+		runtime.SetFinalizer(p, (*Promise).finalize)
+	}
+	if start {
+		go p.drain()
+	}
+}
+
+// react add reaction called with value or reason of settled promise, like js PerformPromiseThen
+func (p *Promise) react(fn func(v interface{}, err error)) {
+	p.mu.Lock()
+	p.handled = true
+	p.reactions = append(p.reactions, func() { fn(p.value, p.reason) })
+	start := p.start()
+	p.mu.Unlock()
+
+	if start {
+		go p.drain()
+	}
+}
+
+// start check should reactions be drained now; p.mu must be held
+func (p *Promise) start() bool {
+	if p.state == pending || p.draining || len(p.reactions) == 0 {
+		return false
+	}
+	p.draining = true
+	return true
+}
+
+// drain call reactions in order until there are no more
+func (p *Promise) drain() {
+	for {
+		p.mu.Lock()
+		if len(p.reactions) == 0 {
+			p.draining = false
+			p.mu.Unlock()
+			return
+		}
+		fn := p.reactions[0]
+		p.reactions = p.reactions[1:]
+		p.mu.Unlock()
+		fn()
+	}
+}
+
+// call resolve with result of fn, reject with its error or panic
+func call(resolve func(v interface{}), reject func(err error), fn func() (interface{}, error)) {
+	defer rejectThrown(reject)
+	v, err := fn()
+	if err != nil {
+		reject(err)
+		return
+	}
+	resolve(v)
+}
+
+// rejectPanic reject with recovered error, other panics are not recovered
+// 	used for executor which run on goroutine of caller
+func rejectPanic(reject func(err error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	err, ok := r.(error)
+	if !ok {
+		panic(r)
+	}
+	reject(err)
+}
+
+// rejectThrown reject with any recovered panic, like js rejects on any thrown value
+// 	used on goroutines of promise, where not recovered panic crash the process
+// 	panic of non-error value is wrapped in error
+func rejectThrown(reject func(err error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("%v", r)
+	}
+	reject(err)
+}
+
+var (
+	hookMu        sync.RWMutex
+	unhandledHook func(p *Promise, reason error)
+)
+
+// OnUnhandledRejection set hook called for rejected promise which never got handler
+// 	promise is reported when it is garbage collected, so its rejection can't be handled anymore
+// 	Then, Catch, Finally, Await & combinators handle promise; nil hook removes previous one
+func OnUnhandledRejection(hook func(p *Promise, reason error)) {
+	hookMu.Lock()
+	unhandledHook = hook
+	hookMu.Unlock()
+}
+
+func (p *Promise) finalize() {
+	p.mu.Lock()
+	handled := p.handled
+	p.mu.Unlock()
+	if handled {
+		return
+	}
+
+	hookMu.RLock()
+	hook := unhandledHook
+	hookMu.RUnlock()
+	if hook != nil {
+		hook(p, p.reason)
+	}
+}
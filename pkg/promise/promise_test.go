@@ -0,0 +1,292 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+var errTest = errors.New("test error")
+
+// await return result of promise, test fails if it isn't settled in time
+func await(t *testing.T, p *Promise) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	v, err := p.Await(ctx)
+	if err == context.DeadlineExceeded {
+		t.Fatalf("promise isn't settled")
+	}
+	return v, err
+}
+
+type thenable struct {
+	value interface{}
+	err   error
+}
+
+func (th thenable) Then(resolve func(v interface{}), reject func(err error)) {
+	if th.err != nil {
+		reject(th.err)
+		return
+	}
+	resolve(th.value)
+	resolve("ignored")
+}
+
+type future struct {
+	value interface{}
+}
+
+func (f future) Await(ctx context.Context) (interface{}, error) {
+	return f.value, nil
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		executor func(resolve func(v interface{}), reject func(err error))
+		value    interface{}
+		err      error
+		descr    string
+	}{
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(1) },
+			value:    1, descr: "resolve",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { reject(errTest) },
+			err:      errTest, descr: "reject",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) {
+				resolve(1)
+				reject(errTest)
+				resolve(2)
+			},
+			value: 1, descr: "only first call has effect",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { panic(errTest) },
+			err:      errTest, descr: "panic with error",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) {
+				resolve(1)
+				panic(errTest)
+			},
+			value: 1, descr: "panic after resolve",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					resolve("later")
+				}()
+			},
+			value: "later", descr: "async resolve",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(Resolve(2)) },
+			value:    2, descr: "resolve with promise",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(Reject(errTest)) },
+			err:      errTest, descr: "resolve with rejected promise",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(thenable{value: 3}) },
+			value:    3, descr: "resolve with thenable",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(thenable{err: errTest}) },
+			err:      errTest, descr: "resolve with rejecting thenable",
+		},
+		{
+			executor: func(resolve func(v interface{}), reject func(err error)) { resolve(future{value: 4}) },
+			value:    4, descr: "resolve with future",
+		},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, New(v.executor))
+		TestLog("New", t, v.descr, got, v.value, v.descr)
+		TestLog("New", t, v.descr, err, v.err, v.descr)
+	}
+}
+
+func TestNewPanic(t *testing.T) {
+	defer func() {
+		TestLog("New", t, "panic", recover(), "not error", "non-error panic isn't recovered")
+	}()
+	New(func(resolve func(v interface{}), reject func(err error)) { panic("not error") })
+}
+
+func TestChainingCycle(t *testing.T) {
+	p, resolve, _ := WithResolvers()
+	resolve(p)
+	_, err := await(t, p)
+	TestLog("WithResolvers", t, "cycle", err, error(&array.TypeError{Message: "Chaining cycle detected for promise #<Promise>"}), "resolve with itself")
+}
+
+func TestThen(t *testing.T) {
+	double := func(v interface{}) (interface{}, error) { return v.(int) * 2, nil }
+	recoverTo := func(v interface{}) func(err error) (interface{}, error) {
+		return func(err error) (interface{}, error) { return v, nil }
+	}
+	fail := func(v interface{}) (interface{}, error) { return nil, errTest }
+	tests := []struct {
+		promise *Promise
+		value   interface{}
+		err     error
+		descr   string
+	}{
+		{Resolve(1).Then(double, nil), 2, nil, "fulfilled handler"},
+		{Resolve(1).Then(double, nil).Then(double, nil), 4, nil, "chain"},
+		{Reject(errTest).Then(double, nil), nil, errTest, "rejection passes through"},
+		{Reject(errTest).Then(double, recoverTo(5)), 5, nil, "rejected handler"},
+		{Resolve(1).Then(nil, recoverTo(5)), 1, nil, "value passes through"},
+		{Resolve(1).Then(fail, nil), nil, errTest, "handler error"},
+		{Resolve(1).Then(func(v interface{}) (interface{}, error) { panic(errTest) }, nil), nil, errTest, "handler panic"},
+		{Resolve(1).Then(func(v interface{}) (interface{}, error) { return Resolve(7), nil }, nil), 7, nil, "handler return promise"},
+		{Resolve(1).Then(func(v interface{}) (interface{}, error) { return thenable{value: 8}, nil }, nil), 8, nil, "handler return thenable"},
+		{Reject(errTest).Catch(recoverTo(6)), 6, nil, "catch"},
+		{Resolve(1).Catch(recoverTo(6)), 1, nil, "catch of fulfilled"},
+		{Resolve(1).Finally(func() error { return nil }), 1, nil, "finally of fulfilled"},
+		{Reject(errTest).Finally(func() error { return nil }), nil, errTest, "finally of rejected"},
+		{Resolve(1).Finally(func() error { return context.Canceled }), nil, context.Canceled, "finally error"},
+	}
+
+	for _, v := range tests {
+		got, err := await(t, v.promise)
+		TestLog("Then", t, v.descr, got, v.value, v.descr)
+		TestLog("Then", t, v.descr, err, v.err, v.descr)
+	}
+}
+
+type panicThenable struct{}
+
+func (panicThenable) Then(resolve func(v interface{}), reject func(err error)) {
+	panic("boom")
+}
+
+func TestThenPanic(t *testing.T) {
+	tests := []struct {
+		promise *Promise
+		descr   string
+	}{
+		{Resolve(1).Then(func(v interface{}) (interface{}, error) { panic("boom") }, nil), "then handler"},
+		{Reject(errTest).Catch(func(err error) (interface{}, error) { panic("boom") }), "catch handler"},
+		{Resolve(1).Finally(func() error { panic("boom") }), "finally handler"},
+		{Resolve(panicThenable{}), "thenable"},
+	}
+
+	for _, v := range tests {
+		_, err := await(t, v.promise)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		TestLog("Then", t, v.descr, msg, "boom", "non-error panic of "+v.descr+" rejects")
+	}
+}
+
+func TestThenOrder(t *testing.T) {
+	p, resolve, _ := WithResolvers()
+	var mu sync.Mutex
+	var order []interface{}
+	record := func(v interface{}) (interface{}, error) {
+		mu.Lock()
+		order = append(order, v)
+		mu.Unlock()
+		return nil, nil
+	}
+
+	var last *Promise
+	for i := 0; i < 5; i++ {
+		i := i
+		last = p.Then(func(interface{}) (interface{}, error) { return record(i) }, nil)
+	}
+	mu.Lock()
+	TestLog("Then", t, "order", len(order), 0, "handlers aren't called before settle")
+	mu.Unlock()
+
+	resolve(nil)
+	await(t, last)
+	mu.Lock()
+	TestLog("Then", t, "order", order, []interface{}{0, 1, 2, 3, 4}, "handlers are called in order")
+	mu.Unlock()
+
+	done := make(chan struct{})
+	Resolve(1).Then(func(v interface{}) (interface{}, error) {
+		close(done)
+		return nil, nil
+	}, nil)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Then: handler of settled promise isn't called")
+	}
+}
+
+func TestAwait(t *testing.T) {
+	p, _, _ := WithResolvers()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := p.Await(ctx)
+	TestLog("Await", t, "cancel", err, context.Canceled, "ctx canceled")
+
+	_, err = Reject(nil).Await(context.Background())
+	TestLog("Await", t, "nil", err, error(&array.TypeError{Message: "Promise rejected with nil reason"}), "nil reason")
+}
+
+func TestOnUnhandledRejection(t *testing.T) {
+	reasons := make(chan error, 10)
+	OnUnhandledRejection(func(p *Promise, reason error) {
+		reasons <- reason
+	})
+	defer OnUnhandledRejection(nil)
+
+	func() {
+		Reject(errTest)
+		h := Reject(context.Canceled)
+		h.Catch(func(err error) (interface{}, error) { return nil, nil })
+		a := Reject(context.DeadlineExceeded)
+		a.Await(context.Background())
+	}()
+
+	var got []error
+	deadline := time.After(time.Second)
+	for len(got) == 0 {
+		runtime.GC()
+		select {
+		case r := <-reasons:
+			got = append(got, r)
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("OnUnhandledRejection: hook isn't called")
+		}
+	}
+	for i := 0; i < 3; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(reasons)
+	for r := range reasons {
+		got = append(got, r)
+	}
+	TestLog("OnUnhandledRejection", t, "reasons", got, []error{errTest}, "only unhandled rejection is reported")
+}
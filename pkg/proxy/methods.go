@@ -0,0 +1,108 @@
+package proxy
+
+import "github.com/miron-developer/golang-js-utils/pkg/array"
+
+type (
+	predicate = func(value array.ArrayItem, index int, array *array.Array) bool
+	mapper    = func(value array.ArrayItem, index int, array *array.Array) array.ArrayItem
+	keyer     = func(value array.ArrayItem, index int, array *array.Array) interface{}
+	reducer   = func(prevValue interface{}, currValue array.ArrayItem, index int, array *array.Array) interface{}
+	comparer  = func(a, b array.ArrayItem) int
+)
+
+type method struct {
+	mutating bool
+	call     func(a *array.Array, args []interface{}) interface{}
+}
+
+// methods is array methods available for Apply by name
+// 	not included are methods which configure target instead of reading or changing elements:
+// 	PreventExtensions, Seal, Freeze, DeepFreeze, IsExtensible, IsSealed, IsFrozen, SetStrict, Err,
+// 	Observe, Batch, SetSemantics, Semantics & ToChannel;
+// 	coercion accessors of ArrayItem, like Number & AsString, work on elements read by Get trap
+var methods = map[string]method{
+	"SetAt": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.SetAt(args[0].(int), args[1])
+	}},
+	"SetLength": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.SetLength(args[0].(int))
+	}},
+	"Push": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Push(args...)
+	}},
+	"Unshift": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Unshift(args...)
+	}},
+	"Pop": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Pop()
+	}},
+	"Shift": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Shift()
+	}},
+	"Slice": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Slice(args[0].(int), args[1].(int))
+	}},
+	"NewSlice": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.NewSlice(args[0].(int), args[1].(int))
+	}},
+	"Fill": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Fill(args[0])
+	}},
+	"Reverse": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Reverse()
+	}},
+	"Sort": {true, func(a *array.Array, args []interface{}) interface{} {
+		return a.Sort(args[0].(comparer))
+	}},
+	"Every": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Every(args[0].(predicate))
+	}},
+	"Some": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Some(args[0].(predicate))
+	}},
+	"Find": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Find(args[0].(predicate))
+	}},
+	"FindIndex": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.FindIndex(args[0].(predicate), args[1].(int))
+	}},
+	"Includes": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Includes(args[0], args[1].(int))
+	}},
+	"Join": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Join(args[0].(string))
+	}},
+	"IndexOf": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.IndexOf(args[0], args[1].(int))
+	}},
+	"LastIndexOf": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.LastIndexOf(args[0], args[1].(int))
+	}},
+	"Filter": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Filter(args[0].(predicate))
+	}},
+	"Map": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Map(args[0].(mapper))
+	}},
+	"Reduce": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.Reduce(args[0].(reducer), args[1])
+	}},
+	"ReduceRight": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.ReduceRight(args[0].(reducer), args[1])
+	}},
+	"GroupBy": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.GroupBy(args[0].(keyer))
+	}},
+	"GroupByToMap": {false, func(a *array.Array, args []interface{}) interface{} {
+		return a.GroupByToMap(args[0].(keyer))
+	}},
+}
+
+// lookup return array method by name; panic with *array.TypeError for unknown method, like js
+func lookup(name string) method {
+	m, ok := methods[name]
+	if !ok {
+		panic(&array.TypeError{Message: name + " is not a function"})
+	}
+	return m
+}
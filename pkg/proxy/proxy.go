@@ -0,0 +1,303 @@
+package proxy
+
+import (
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// Handler is set of optional traps, like js proxy handler
+// 	nil trap perform default behavior, same as Reflect
+// 	Apply is called for every array method of Proxy
+type Handler struct {
+	Get            func(target *array.Array, key string, receiver *Proxy) interface{}
+	Set            func(target *array.Array, key string, value interface{}, receiver *Proxy) bool
+	Has            func(target *array.Array, key string) bool
+	DeleteProperty func(target *array.Array, key string) bool
+	OwnKeys        func(target *array.Array) []string
+	Apply          func(target *array.Array, method string, args []interface{}, receiver *Proxy) interface{}
+}
+
+// Proxy is Array wrapper which access go through handler traps, like js Proxy
+// 	array methods read elements with Has & Get traps and write changes with Set & DeleteProperty traps,
+// 	so callbacks get temporary array with elements read through traps
+type Proxy struct {
+	target  *array.Array
+	handler *Handler
+	err     error
+}
+
+// New return new Proxy of target
+func New(target *array.Array, handler *Handler) *Proxy {
+	if handler == nil {
+		handler = &Handler{}
+	}
+	return &Proxy{target: target, handler: handler}
+}
+
+// Target return proxied array
+func (p *Proxy) Target() *array.Array {
+	return p.target
+}
+
+// Err return *array.TypeError of last change rejected by trap or nil
+func (p *Proxy) Err() error {
+	return p.err
+}
+
+// Get return property by key through Get trap
+func (p *Proxy) Get(key string) interface{} {
+	if p.handler.Get != nil {
+		return p.handler.Get(p.target, key, p)
+	}
+	return Reflect.Get(p.target, key)
+}
+
+// Set set property by key through Set trap
+func (p *Proxy) Set(key string, value interface{}) bool {
+	if p.handler.Set != nil {
+		return p.handler.Set(p.target, key, value, p)
+	}
+	return Reflect.Set(p.target, key, value)
+}
+
+// Has check property by key through Has trap
+func (p *Proxy) Has(key string) bool {
+	if p.handler.Has != nil {
+		return p.handler.Has(p.target, key)
+	}
+	return Reflect.Has(p.target, key)
+}
+
+// Delete remove property by key through DeleteProperty trap
+func (p *Proxy) Delete(key string) bool {
+	if p.handler.DeleteProperty != nil {
+		return p.handler.DeleteProperty(p.target, key)
+	}
+	return Reflect.DeleteProperty(p.target, key)
+}
+
+// OwnKeys return property keys through OwnKeys trap
+func (p *Proxy) OwnKeys() []string {
+	if p.handler.OwnKeys != nil {
+		return p.handler.OwnKeys(p.target)
+	}
+	return Reflect.OwnKeys(p.target)
+}
+
+// Len return length through Get trap
+func (p *Proxy) Len() int {
+	n, _ := p.Get(LengthKey).(int)
+	return n
+}
+
+// Apply call array method by name through Apply trap
+func (p *Proxy) Apply(method string, args ...interface{}) interface{} {
+	if p.handler.Apply != nil {
+		return p.handler.Apply(p.target, method, args, p)
+	}
+	return Reflect.Apply(p.target, method, args, p)
+}
+
+// invoke run array method on elements read through traps and write back changes
+// 	temporary array is new array with semantics of target; missing elements are holes of SetLength
+func (p *Proxy) invoke(name string, args []interface{}) interface{} {
+	m := lookup(name)
+
+	tmp := array.NewArray().SetSemantics(p.target.Semantics())
+	for i, n := 0, p.Len(); i < n; i++ {
+		if key := strconv.Itoa(i); p.Has(key) {
+			tmp.Push(p.Get(key))
+		} else {
+			tmp.SetLength(i + 1)
+		}
+	}
+	old := append([]array.ArrayItem{}, tmp.Items...)
+
+	r := m.call(tmp, args)
+	if m.mutating {
+		p.writeBack(old, tmp.Items)
+	}
+	if a, ok := r.(*array.Array); ok && a == tmp {
+		return p
+	}
+	return r
+}
+
+func (p *Proxy) writeBack(old, items []array.ArrayItem) {
+	for i, v := range items {
+		if i < len(old) && array.SameValueZero(old[i].Data, v.Data) {
+			continue
+		}
+		if key := strconv.Itoa(i); !p.Set(key, v.Data) {
+			p.err = &array.TypeError{Message: "'set' on proxy: trap returned falsish for property '" + key + "'"}
+			return
+		}
+	}
+	for i := len(old) - 1; i >= len(items); i-- {
+		if key := strconv.Itoa(i); !p.Delete(key) {
+			p.err = &array.TypeError{Message: "'deleteProperty' on proxy: trap returned falsish for property '" + key + "'"}
+			return
+		}
+	}
+	if len(items) != len(old) && !p.Set(LengthKey, len(items)) {
+		p.err = &array.TypeError{Message: "'set' on proxy: trap returned falsish for property 'length'"}
+	}
+}
+
+// SetAt set element data at index, like js arr[index] = data
+func (p *Proxy) SetAt(index int, data interface{}) bool {
+	r, _ := p.Apply("SetAt", index, data).(bool)
+	return r
+}
+
+// SetLength change array length, like js arr.length = n
+func (p *Proxy) SetLength(n int) bool {
+	r, _ := p.Apply("SetLength", n).(bool)
+	return r
+}
+
+// Push just push to end items
+func (p *Proxy) Push(items ...interface{}) *Proxy {
+	p.Apply("Push", items...)
+	return p
+}
+
+// Unshift just push to start items
+func (p *Proxy) Unshift(items ...interface{}) *Proxy {
+	p.Apply("Unshift", items...)
+	return p
+}
+
+// NewSlice return new slice between start & end
+func (p *Proxy) NewSlice(start, end int) *array.Array {
+	r, _ := p.Apply("NewSlice", start, end).(*array.Array)
+	return r
+}
+
+// Slice make array to slice between start & end
+func (p *Proxy) Slice(start, end int) *Proxy {
+	p.Apply("Slice", start, end)
+	return p
+}
+
+// Pop return&remove last element
+func (p *Proxy) Pop() array.ArrayItem {
+	r, _ := p.Apply("Pop").(array.ArrayItem)
+	return r
+}
+
+// Shift return&remove first element
+func (p *Proxy) Shift() array.ArrayItem {
+	r, _ := p.Apply("Shift").(array.ArrayItem)
+	return r
+}
+
+// Every check is every element equal to data
+func (p *Proxy) Every(callback func(value array.ArrayItem, index int, array *array.Array) bool) bool {
+	r, _ := p.Apply("Every", callback).(bool)
+	return r
+}
+
+// Some check is have at least one element equal to data
+func (p *Proxy) Some(callback func(value array.ArrayItem, index int, array *array.Array) bool) bool {
+	r, _ := p.Apply("Some", callback).(bool)
+	return r
+}
+
+// Find return finded element searched by callback or empty ArrayItem
+func (p *Proxy) Find(callback func(value array.ArrayItem, index int, array *array.Array) bool) array.ArrayItem {
+	r, _ := p.Apply("Find", callback).(array.ArrayItem)
+	return r
+}
+
+// FindIndex return finded element index searched by callback or -1
+func (p *Proxy) FindIndex(callback func(value array.ArrayItem, index int, array *array.Array) bool, fromIndex int) int {
+	r, ok := p.Apply("FindIndex", callback, fromIndex).(int)
+	if !ok {
+		return -1
+	}
+	return r
+}
+
+// Includes check is have at least one element equal to data
+func (p *Proxy) Includes(data interface{}, fromIndex int) bool {
+	r, _ := p.Apply("Includes", data, fromIndex).(bool)
+	return r
+}
+
+// Fill fill all element equal to data
+func (p *Proxy) Fill(data interface{}) *Proxy {
+	p.Apply("Fill", data)
+	return p
+}
+
+// Join return joined by separator string
+func (p *Proxy) Join(separator string) string {
+	r, _ := p.Apply("Join", separator).(string)
+	return r
+}
+
+// IndexOf return finding element index or -1
+func (p *Proxy) IndexOf(data interface{}, fromIndex int) int {
+	r, ok := p.Apply("IndexOf", data, fromIndex).(int)
+	if !ok {
+		return -1
+	}
+	return r
+}
+
+// LastIndexOf return last finding element index or -1
+func (p *Proxy) LastIndexOf(data interface{}, fromIndex int) int {
+	r, ok := p.Apply("LastIndexOf", data, fromIndex).(int)
+	if !ok {
+		return -1
+	}
+	return r
+}
+
+// Reverse reverse array
+func (p *Proxy) Reverse() *Proxy {
+	p.Apply("Reverse")
+	return p
+}
+
+// Filter return new filtered array; remove elements not equal in callback
+func (p *Proxy) Filter(callback func(value array.ArrayItem, index int, array *array.Array) bool) *array.Array {
+	r, _ := p.Apply("Filter", callback).(*array.Array)
+	return r
+}
+
+// Map return new array; elements maked in callback
+func (p *Proxy) Map(callback func(value array.ArrayItem, index int, array *array.Array) array.ArrayItem) *array.Array {
+	r, _ := p.Apply("Map", callback).(*array.Array)
+	return r
+}
+
+// Reduce return common data for all array; data maked in callback in ascending order
+func (p *Proxy) Reduce(callback func(prevValue interface{}, currValue array.ArrayItem, index int, array *array.Array) interface{}, initValue interface{}) interface{} {
+	return p.Apply("Reduce", callback, initValue)
+}
+
+// ReduceRight return common data for all array; data maked in callback in descending order
+func (p *Proxy) ReduceRight(callback func(prevValue interface{}, currValue array.ArrayItem, index int, array *array.Array) interface{}, initValue interface{}) interface{} {
+	return p.Apply("ReduceRight", callback, initValue)
+}
+
+// Sort sort array by compareFunction
+func (p *Proxy) Sort(compareFunction func(a, b array.ArrayItem) int) *Proxy {
+	p.Apply("Sort", compareFunction)
+	return p
+}
+
+// GroupBy return elements grouped by callback key, like js Object.groupBy
+func (p *Proxy) GroupBy(callback func(value array.ArrayItem, index int, array *array.Array) interface{}) map[string]*array.Array {
+	r, _ := p.Apply("GroupBy", callback).(map[string]*array.Array)
+	return r
+}
+
+// GroupByToMap return elements grouped by callback key, like js Map.groupBy
+func (p *Proxy) GroupByToMap(callback func(value array.ArrayItem, index int, array *array.Array) interface{}) []array.Group {
+	r, _ := p.Apply("GroupByToMap", callback).([]array.Group)
+	return r
+}
@@ -0,0 +1,183 @@
+package proxy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/value"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestProxyDefault(t *testing.T) {
+	arr := array.MakeArray(3, 1, 2)
+	p := New(arr, nil)
+
+	p.Push(4).Sort(func(a, b array.ArrayItem) int { return a.Data.(int) - b.Data.(int) })
+	TestLog("Proxy", t, arr.Items, arr, array.MakeArray(1, 2, 3, 4), "push & sort on target")
+	TestLog("Proxy", t, arr.Items, p.Pop(), array.ArrayItem{Data: 4}, "pop")
+	TestLog("Proxy", t, arr.Items, p.Shift(), array.ArrayItem{Data: 1}, "shift")
+	TestLog("Proxy", t, arr.Items, arr, array.MakeArray(2, 3), "target after pop & shift")
+	TestLog("Proxy", t, arr.Items, p.Join("-"), "2-3", "join")
+	TestLog("Proxy", t, arr.Items, p.IndexOf(3, 0), 1, "indexOf")
+	TestLog("Proxy", t, arr.Items, p.Len(), 2, "length")
+	TestLog("Proxy", t, arr.Items, p.OwnKeys(), []string{"0", "1", "length"}, "own keys")
+}
+
+func TestProxyGetTrap(t *testing.T) {
+	// virtual array of squares
+	p := New(array.NewArray(), &Handler{
+		Get: func(target *array.Array, key string, receiver *Proxy) interface{} {
+			if key == LengthKey {
+				return 4
+			}
+			i := index(key)
+			return i * i
+		},
+		Has: func(target *array.Array, key string) bool {
+			return true
+		},
+	})
+
+	got := p.Map(func(value array.ArrayItem, index int, arr *array.Array) array.ArrayItem {
+		return array.ArrayItem{Data: value.Data.(int) + 1}
+	})
+	TestLog("Get", t, "squares", got, array.MakeArray(1, 2, 5, 10), "virtual array map")
+
+	sum := p.Reduce(func(prev interface{}, curr array.ArrayItem, index int, arr *array.Array) interface{} {
+		return prev.(int) + curr.Data.(int)
+	}, 0)
+	TestLog("Get", t, "squares", sum, 14, "virtual array reduce")
+
+	arr := array.MakeArray(1, 2, 3)
+	p = New(arr, &Handler{
+		Get: func(target *array.Array, key string, receiver *Proxy) interface{} {
+			v := Reflect.Get(target, key)
+			if n, ok := v.(int); ok && key != LengthKey {
+				return n * 10
+			}
+			return v
+		},
+	})
+	TestLog("Get", t, arr.Items, p.Join(","), "10,20,30", "join read through trap")
+	TestLog("Get", t, arr.Items, arr, array.MakeArray(1, 2, 3), "read-only method doesn't change target")
+}
+
+func TestProxySetTrap(t *testing.T) {
+	arr := array.MakeArray(1, 2)
+	var log []string
+	p := New(arr, &Handler{
+		Set: func(target *array.Array, key string, value interface{}, receiver *Proxy) bool {
+			log = append(log, key)
+			if _, ok := value.(int); !ok && key != LengthKey {
+				return false
+			}
+			return Reflect.Set(target, key, value)
+		},
+	})
+
+	p.Push(3)
+	TestLog("Set", t, arr.Items, arr, array.MakeArray(1, 2, 3), "valid push")
+	TestLog("Set", t, arr.Items, log, []string{"2", "length"}, "set trap called for new element & length")
+	TestLog("Set", t, arr.Items, p.Err(), nil, "no error")
+
+	p.Push("str")
+	TestLog("Set", t, arr.Items, arr, array.MakeArray(1, 2, 3), "invalid push")
+	_, ok := p.Err().(*array.TypeError)
+	TestLog("Set", t, arr.Items, ok, true, "rejected set error")
+}
+
+func TestProxyDeleteTrap(t *testing.T) {
+	arr := array.MakeArray(1, 2, 3)
+	var deleted []string
+	p := New(arr, &Handler{
+		DeleteProperty: func(target *array.Array, key string) bool {
+			deleted = append(deleted, key)
+			return Reflect.DeleteProperty(target, key)
+		},
+	})
+
+	p.Slice(0, 1)
+	TestLog("DeleteProperty", t, arr.Items, arr, array.MakeArray(1), "slice")
+	TestLog("DeleteProperty", t, arr.Items, deleted, []string{"2", "1"}, "delete trap called from end")
+}
+
+func TestProxyApplyTrap(t *testing.T) {
+	arr := array.MakeArray(1, 2, 3)
+	var calls []string
+	p := New(arr, &Handler{
+		Apply: func(target *array.Array, method string, args []interface{}, receiver *Proxy) interface{} {
+			calls = append(calls, method)
+			if method == "Includes" {
+				return false
+			}
+			return Reflect.Apply(target, method, args, receiver)
+		},
+	})
+
+	p.Reverse()
+	TestLog("Apply", t, arr.Items, arr, array.MakeArray(3, 2, 1), "default apply")
+	TestLog("Apply", t, arr.Items, p.Includes(1, 0), false, "overridden method")
+	TestLog("Apply", t, arr.Items, calls, []string{"Reverse", "Includes"}, "apply trap calls")
+}
+
+func TestProxyFrozenTarget(t *testing.T) {
+	arr := array.MakeArray(1).Freeze()
+	p := New(arr, nil)
+	p.Push(2)
+	TestLog("Frozen", t, arr.Items, arr.Items, array.MakeArray(1).Items, "frozen target is not changed")
+	_, ok := p.Err().(*array.TypeError)
+	TestLog("Frozen", t, arr.Items, ok, true, "error on frozen target")
+}
+
+func TestProxySetAtSetLength(t *testing.T) {
+	arr := array.MakeArray(1, 2)
+	var log []string
+	p := New(arr, &Handler{
+		Set: func(target *array.Array, key string, value interface{}, receiver *Proxy) bool {
+			log = append(log, key)
+			return Reflect.Set(target, key, value)
+		},
+	})
+
+	TestLog("SetAt", t, arr.Items, p.SetAt(3, 4), true, "set after end")
+	TestLog("SetAt", t, arr.Items, arr, array.MakeArray(1, 2, nil, 4), "hole is nil")
+	TestLog("SetAt", t, arr.Items, log, []string{"2", "3", "length"}, "set trap called for new elements & length")
+
+	TestLog("SetLength", t, arr.Items, p.SetLength(1), true, "shrink")
+	TestLog("SetLength", t, arr.Items, arr, array.MakeArray(1), "shrunk target")
+}
+
+func TestProxyUnknownMethod(t *testing.T) {
+	p := New(array.MakeArray(1), nil)
+	defer func() {
+		TestLog("Apply", t, "Psuh", recover(), interface{}(&array.TypeError{Message: "Psuh is not a function"}), "unknown method panics")
+	}()
+	p.Apply("Psuh", 2)
+}
+
+func TestProxySemantics(t *testing.T) {
+	arr := array.MakeArray(1, "a").SetSemantics(value.ArraySemantics)
+	p := New(arr, nil)
+
+	var semantics array.Semantics
+	p.Every(func(v array.ArrayItem, index int, a *array.Array) bool {
+		semantics = a.Semantics()
+		return true
+	})
+	TestLog("Semantics", t, arr.Items, semantics, value.ArraySemantics, "callback array has target semantics")
+	TestLog("Semantics", t, arr.Items, p.Includes(value.Number(1), 0), true, "includes compare by target semantics")
+
+	p.Push(2)
+	TestLog("Semantics", t, arr.Items, arr.Items[2].Data, value.Of(2), "pushed data is wrapped")
+	p.SetLength(4)
+	TestLog("Semantics", t, arr.Items, arr.Items[3].Data, value.Undefined, "new element is undefined")
+}
@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// LengthKey is property key of array length
+const LengthKey = "length"
+
+type reflector struct{}
+
+// Reflect perform default behavior of traps on target, like js Reflect
+var Reflect reflector
+
+// index return array index of property key or -1
+func index(key string) int {
+	if key == "" || (key[0] == '0' && len(key) > 1) {
+		return -1
+	}
+	i, err := strconv.Atoi(key)
+	if err != nil || i < 0 {
+		return -1
+	}
+	return i
+}
+
+// Get return target property: element data by index key or length
+func (reflector) Get(target *array.Array, key string) interface{} {
+	if key == LengthKey {
+		return len(target.Items)
+	}
+	if i := index(key); i >= 0 && i < len(target.Items) {
+		return target.Items[i].Data
+	}
+	return nil
+}
+
+// Set set target property: element data by index key or length
+// 	return false if property can't be set
+func (reflector) Set(target *array.Array, key string, value interface{}) bool {
+	if key == LengthKey {
+		n, ok := value.(int)
+		return ok && target.SetLength(n)
+	}
+	i := index(key)
+	return i >= 0 && target.SetAt(i, value)
+}
+
+// Has check is target have property
+func (reflector) Has(target *array.Array, key string) bool {
+	if key == LengthKey {
+		return true
+	}
+	i := index(key)
+	return i >= 0 && i < len(target.Items)
+}
+
+// DeleteProperty remove element by index key
+// 	array can't have holes, so element data is set to nil
+// 	return false for length and elements of sealed array
+func (reflector) DeleteProperty(target *array.Array, key string) bool {
+	if key == LengthKey {
+		return false
+	}
+	i := index(key)
+	if i < 0 || i >= len(target.Items) {
+		return true
+	}
+	if target.IsSealed() {
+		return false
+	}
+	return target.SetAt(i, nil)
+}
+
+// OwnKeys return target property keys: indexes & length
+func (reflector) OwnKeys(target *array.Array) []string {
+	keys := make([]string, 0, len(target.Items)+1)
+	for i := range target.Items {
+		keys = append(keys, strconv.Itoa(i))
+	}
+	return append(keys, LengthKey)
+}
+
+// Apply call array method with args
+// 	if receiver is not nil, method is run through receiver traps, else directly on target
+// 	panic with *array.TypeError for unknown method, like js "x is not a function"
+func (reflector) Apply(target *array.Array, method string, args []interface{}, receiver *Proxy) interface{} {
+	if receiver != nil {
+		return receiver.invoke(method, args)
+	}
+	return lookup(method).call(target, args)
+}
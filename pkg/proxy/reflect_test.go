@@ -0,0 +1,69 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestReflectGet(t *testing.T) {
+	arr := array.MakeArray("a", "b")
+	tests := []struct {
+		key         string
+		want        interface{}
+		description string
+	}{
+		{"0", "a", "index"},
+		{"length", 2, "length"},
+		{"2", nil, "out of range"},
+		{"01", nil, "not canonical index"},
+		{"-1", nil, "negative index"},
+	}
+
+	for _, tt := range tests {
+		TestLog("Reflect.Get", t, tt.key, Reflect.Get(arr, tt.key), tt.want, tt.description)
+	}
+}
+
+func TestReflectSet(t *testing.T) {
+	tests := []struct {
+		arr         *array.Array
+		key         string
+		value       interface{}
+		want        *array.Array
+		ok          bool
+		description string
+	}{
+		{array.MakeArray(1), "0", 2, array.MakeArray(2), true, "set index"},
+		{array.MakeArray(1), "2", 2, array.MakeArray(1, nil, 2), true, "set out of range"},
+		{array.MakeArray(1, 2), "length", 1, array.MakeArray(1), true, "set length"},
+		{array.MakeArray(1), "length", "1", array.MakeArray(1), false, "set length not int"},
+		{array.MakeArray(1), "x", 2, array.MakeArray(1), false, "set not index"},
+	}
+
+	for _, tt := range tests {
+		ok := Reflect.Set(tt.arr, tt.key, tt.value)
+		TestLog("Reflect.Set", t, tt.key, tt.arr.Items, tt.want.Items, tt.description)
+		TestLog("Reflect.Set", t, tt.key, ok, tt.ok, tt.description+" result")
+	}
+}
+
+func TestReflectHasDelete(t *testing.T) {
+	arr := array.MakeArray(1, 2)
+	TestLog("Reflect.Has", t, "1", Reflect.Has(arr, "1"), true, "has index")
+	TestLog("Reflect.Has", t, "2", Reflect.Has(arr, "2"), false, "has out of range")
+	TestLog("Reflect.DeleteProperty", t, "1", Reflect.DeleteProperty(arr, "1"), true, "delete index")
+	TestLog("Reflect.DeleteProperty", t, "1", arr, array.MakeArray(1, nil), "deleted element is nil")
+	TestLog("Reflect.DeleteProperty", t, "length", Reflect.DeleteProperty(arr, "length"), false, "delete length")
+	TestLog("Reflect.DeleteProperty", t, "0", Reflect.DeleteProperty(arr.Seal(), "0"), false, "delete in sealed")
+}
+
+func TestReflectApply(t *testing.T) {
+	arr := array.MakeArray(1, 2)
+	TestLog("Reflect.Apply", t, "Push", Reflect.Apply(arr, "Push", []interface{}{3}, nil), array.MakeArray(1, 2, 3), "direct call")
+
+	defer func() {
+		TestLog("Reflect.Apply", t, "Unknown", recover(), interface{}(&array.TypeError{Message: "Unknown is not a function"}), "unknown method")
+	}()
+	Reflect.Apply(arr, "Unknown", nil, nil)
+}
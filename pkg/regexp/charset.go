@@ -0,0 +1,279 @@
+package regexp
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// charSet is set of code points; in v mode class can also contain strings
+type charSet struct {
+	has func(c rune) bool
+	// strs are strings of length other than 1, longest first
+	strs [][]rune
+}
+
+func runeSet(r rune) *charSet {
+	return &charSet{has: func(c rune) bool { return c == r }}
+}
+
+func rangeSet(lo, hi rune) *charSet {
+	return &charSet{has: func(c rune) bool { return c >= lo && c <= hi }}
+}
+
+func funcSet(f func(c rune) bool) *charSet {
+	return &charSet{has: f}
+}
+
+var emptySet = funcSet(func(c rune) bool { return false })
+
+// union return set of code points & strings of both sets
+func union(a, b *charSet) *charSet {
+	return &charSet{
+		has:  func(c rune) bool { return a.has(c) || b.has(c) },
+		strs: sortStrings(append(append([][]rune{}, a.strs...), b.strs...)),
+	}
+}
+
+// intersect return set of code points & strings which are in both sets
+func intersect(a, b *charSet) *charSet {
+	r := &charSet{has: func(c rune) bool { return a.has(c) && b.has(c) }}
+	for _, s := range a.strs {
+		if containsString(b.strs, s) {
+			r.strs = append(r.strs, s)
+		}
+	}
+	return r
+}
+
+// subtract return set of code points & strings of a which are not in b
+func subtract(a, b *charSet) *charSet {
+	r := &charSet{has: func(c rune) bool { return a.has(c) && !b.has(c) }}
+	for _, s := range a.strs {
+		if !containsString(b.strs, s) {
+			r.strs = append(r.strs, s)
+		}
+	}
+	return r
+}
+
+// complement return set of code points not in a; set with strings can't be complemented
+func complement(a *charSet) *charSet {
+	return &charSet{has: func(c rune) bool { return !a.has(c) }}
+}
+
+func containsString(strs [][]rune, s []rune) bool {
+	for _, v := range strs {
+		if string(v) == string(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortStrings remove duplicates & sort strings longest first, like js class strings alternatives
+func sortStrings(strs [][]rune) [][]rune {
+	r := [][]rune{}
+	for _, s := range strs {
+		if !containsString(r, s) {
+			r = append(r, s)
+		}
+	}
+	sort.SliceStable(r, func(i, j int) bool { return len(r[i]) > len(r[j]) })
+	if len(r) == 0 {
+		return nil
+	}
+	return r
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c == '_'
+}
+
+// isSpace check is c js WhiteSpace or LineTerminator
+func isSpace(c rune) bool {
+	switch c {
+	case '\t', '\n', '\v', '\f', '\r', ' ', '\u00a0', '\u1680', '\u2028', '\u2029', '\u202f', '\u205f', '\u3000', '\ufeff':
+		return true
+	}
+	return c >= '\u2000' && c <= '\u200a'
+}
+
+func isLineTerminator(c rune) bool {
+	return c == '\n' || c == '\r' || c == '\u2028' || c == '\u2029'
+}
+
+// classEscape return set of \d, \w, \s & their negations
+// 	with u & i flags \w also contains U+017F & U+212A which are case variants of s & k
+func classEscape(c rune, unicodeCase bool) *charSet {
+	var set *charSet
+	switch c {
+	case 'd', 'D':
+		set = funcSet(isDigit)
+	case 'w', 'W':
+		set = funcSet(isWordChar)
+		if unicodeCase {
+			set = funcSet(func(c rune) bool { return isWordChar(c) || c == '\u017f' || c == '\u212a' })
+		}
+	case 's', 'S':
+		set = funcSet(isSpace)
+	}
+	if unicode.IsUpper(c) {
+		return complement(set)
+	}
+	return set
+}
+
+// categoryAliases map long general category names to short ones
+var categoryAliases = map[string]string{
+	"Letter":                "L",
+	"Cased_Letter":          "LC",
+	"Uppercase_Letter":      "Lu",
+	"Lowercase_Letter":      "Ll",
+	"Titlecase_Letter":      "Lt",
+	"Modifier_Letter":       "Lm",
+	"Other_Letter":          "Lo",
+	"Mark":                  "M",
+	"Combining_Mark":        "M",
+	"Nonspacing_Mark":       "Mn",
+	"Spacing_Mark":          "Mc",
+	"Enclosing_Mark":        "Me",
+	"Number":                "N",
+	"Decimal_Number":        "Nd",
+	"digit":                 "Nd",
+	"Letter_Number":         "Nl",
+	"Other_Number":          "No",
+	"Punctuation":           "P",
+	"punct":                 "P",
+	"Connector_Punctuation": "Pc",
+	"Dash_Punctuation":      "Pd",
+	"Open_Punctuation":      "Ps",
+	"Close_Punctuation":     "Pe",
+	"Initial_Punctuation":   "Pi",
+	"Final_Punctuation":     "Pf",
+	"Other_Punctuation":     "Po",
+	"Symbol":                "S",
+	"Math_Symbol":           "Sm",
+	"Currency_Symbol":       "Sc",
+	"Modifier_Symbol":       "Sk",
+	"Other_Symbol":          "So",
+	"Separator":             "Z",
+	"Space_Separator":       "Zs",
+	"Line_Separator":        "Zl",
+	"Paragraph_Separator":   "Zp",
+	"Other":                 "C",
+	"Control":               "Cc",
+	"cntrl":                 "Cc",
+	"Format":                "Cf",
+	"Surrogate":             "Cs",
+	"Private_Use":           "Co",
+	"Unassigned":            "Cn",
+}
+
+func isAssigned(c rune) bool {
+	for _, t := range unicode.Categories {
+		if unicode.Is(t, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// category return set of general category by long or short name
+func category(name string) *charSet {
+	if short, ok := categoryAliases[name]; ok {
+		name = short
+	}
+	switch name {
+	case "LC":
+		return funcSet(func(c rune) bool {
+			return unicode.In(c, unicode.Lu, unicode.Ll, unicode.Lt)
+		})
+	case "Cn":
+		return funcSet(func(c rune) bool { return !isAssigned(c) })
+	case "C":
+		return funcSet(func(c rune) bool { return unicode.Is(unicode.C, c) || !isAssigned(c) })
+	}
+	if t, ok := unicode.Categories[name]; ok {
+		return funcSet(func(c rune) bool { return unicode.Is(t, c) })
+	}
+	return nil
+}
+
+// binaryProperty return set of binary unicode property
+func binaryProperty(name string) *charSet {
+	switch name {
+	case "Any":
+		return funcSet(func(c rune) bool { return true })
+	case "ASCII":
+		return rangeSet(0, 0x7f)
+	case "Assigned":
+		return funcSet(isAssigned)
+	case "Alphabetic", "Alpha":
+		return funcSet(func(c rune) bool {
+			return unicode.In(c, unicode.L, unicode.Nl, unicode.Other_Alphabetic)
+		})
+	case "Lowercase", "Lower":
+		return funcSet(func(c rune) bool { return unicode.In(c, unicode.Ll, unicode.Other_Lowercase) })
+	case "Uppercase", "Upper":
+		return funcSet(func(c rune) bool { return unicode.In(c, unicode.Lu, unicode.Other_Uppercase) })
+	}
+	if t, ok := unicode.Properties[name]; ok {
+		return funcSet(func(c rune) bool { return unicode.Is(t, c) })
+	}
+	return nil
+}
+
+// property return set of \p{name} or \p{name=value} or nil for unknown property
+func property(expr string) *charSet {
+	name, value := expr, ""
+	if i := strings.IndexByte(expr, '='); i >= 0 {
+		name, value = expr[:i], expr[i+1:]
+		switch name {
+		case "General_Category", "gc":
+			return category(value)
+		case "Script", "sc", "Script_Extensions", "scx":
+			if t, ok := unicode.Scripts[value]; ok {
+				return funcSet(func(c rune) bool { return unicode.Is(t, c) })
+			}
+		}
+		return nil
+	}
+	if set := category(name); set != nil {
+		return set
+	}
+	return binaryProperty(name)
+}
+
+// canonicalize return case-insensitive form of c, like js Canonicalize
+// 	in unicode mode simple case folding is used,
+// 	otherwise upper case which doesn't map non-ASCII char to ASCII
+func canonicalize(c rune, unicodeMode bool) rune {
+	if unicodeMode {
+		min := c
+		for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+			if f < min {
+				min = f
+			}
+		}
+		return min
+	}
+	u := unicode.ToUpper(c)
+	if c >= 0x80 && u < 0x80 || u > 0xffff {
+		return c
+	}
+	return u
+}
+
+// folds return other chars of c simple case folding orbit
+func folds(c rune) []rune {
+	var r []rune
+	for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+		r = append(r, f)
+	}
+	return r
+}
@@ -0,0 +1,43 @@
+package regexp
+
+import "testing"
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		a, b        rune
+		unicode     bool
+		want        bool
+		description string
+	}{
+		{a: 'a', b: 'A', want: true, description: "ascii"},
+		{a: 'ſ', b: 's', want: false, description: "non-ASCII to ASCII is not mapped"},
+		{a: 'ſ', b: 's', unicode: true, want: true, description: "simple case folding"},
+		{a: 'K', b: 'k', unicode: true, want: true, description: "kelvin sign"},
+	}
+
+	for _, tt := range tests {
+		got := canonicalize(tt.a, tt.unicode) == canonicalize(tt.b, tt.unicode)
+		TestLog("canonicalize", t, string(tt.a)+string(tt.b), got, tt.want, tt.description)
+	}
+}
+
+func TestProperty(t *testing.T) {
+	tests := []struct {
+		name        string
+		c           rune
+		want        bool
+		description string
+	}{
+		{name: "L", c: 'a', want: true, description: "short category"},
+		{name: "Lowercase_Letter", c: 'A', want: false, description: "long category"},
+		{name: "gc=Nd", c: '7', want: true, description: "general category value"},
+		{name: "sc=Cyrillic", c: 'д', want: true, description: "script"},
+		{name: "ASCII", c: 'é', want: false, description: "ascii"},
+		{name: "White_Space", c: '　', want: true, description: "binary property"},
+	}
+
+	for _, tt := range tests {
+		TestLog("property", t, tt.name, property(tt.name).has(tt.c), tt.want, tt.description)
+	}
+	TestLog("property", t, "Foo", property("Foo"), (*charSet)(nil), "unknown")
+}
@@ -0,0 +1,7 @@
+package regexp
+
+import "errors"
+
+// ErrStepLimit returned when match take more backtracking steps than allowed
+var ErrStepLimit = errors.New("RangeError: Maximum regular expression backtracking steps exceeded")
+
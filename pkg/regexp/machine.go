@@ -0,0 +1,382 @@
+package regexp
+
+// cont is continuation: rest of pattern which must match from pos
+type cont func(pos int) bool
+
+// matcher match part of pattern at pos and call k for each way to match it
+// 	backtracking is return from k with false
+type matcher func(m *machine, pos int, k cont) bool
+
+// machine is state of one match
+type machine struct {
+	in                                  []uint16
+	unicode, ignoreCase, multiline, dot bool
+	caps                                []int
+	steps, limit                        int
+}
+
+// stepLimitPanic is used to unwind matcher when step limit is exceeded
+type stepLimitPanic struct{}
+
+func (m *machine) step() {
+	m.steps++
+	if m.steps > m.limit {
+		panic(stepLimitPanic{})
+	}
+}
+
+func isHigh(u uint16) bool {
+	return u >= 0xd800 && u <= 0xdbff
+}
+
+func isLow(u uint16) bool {
+	return u >= 0xdc00 && u <= 0xdfff
+}
+
+func decodePair(hi, lo uint16) rune {
+	return (rune(hi)-0xd800)<<10 + rune(lo) - 0xdc00 + 0x10000
+}
+
+// read return char after pos or before pos for backward; in unicode mode char is code point
+func (m *machine) read(pos int, backward bool) (c rune, next int, ok bool) {
+	if backward {
+		if pos <= 0 {
+			return 0, pos, false
+		}
+		if m.unicode && pos >= 2 && isLow(m.in[pos-1]) && isHigh(m.in[pos-2]) {
+			return decodePair(m.in[pos-2], m.in[pos-1]), pos - 2, true
+		}
+		return rune(m.in[pos-1]), pos - 1, true
+	}
+	if pos >= len(m.in) {
+		return 0, pos, false
+	}
+	if m.unicode && pos+1 < len(m.in) && isHigh(m.in[pos]) && isLow(m.in[pos+1]) {
+		return decodePair(m.in[pos], m.in[pos+1]), pos + 2, true
+	}
+	return rune(m.in[pos]), pos + 1, true
+}
+
+// equal check are chars equal with respect of i flag
+func (m *machine) equal(a, b rune) bool {
+	return a == b || m.ignoreCase && canonicalize(a, m.unicode) == canonicalize(b, m.unicode)
+}
+
+// has check is c in set with respect of i flag
+func (m *machine) has(set *charSet, c rune) bool {
+	if set.has(c) {
+		return true
+	}
+	if !m.ignoreCase {
+		return false
+	}
+	canon := canonicalize(c, m.unicode)
+	for _, f := range folds(c) {
+		if (m.unicode || canonicalize(f, false) == canon) && set.has(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// advance return index after char at index; in unicode mode surrogate pair is one char
+func advance(in []uint16, index int, unicodeMode bool) int {
+	if unicodeMode && index+1 < len(in) && isHigh(in[index]) && isLow(in[index+1]) {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *machine) isWord(pos int) bool {
+	if pos < 0 || pos >= len(m.in) {
+		return false
+	}
+	c := rune(m.in[pos])
+	return isWordChar(c) || m.unicode && m.ignoreCase && (c == '\u017f' || c == '\u212a')
+}
+
+// compile return matcher of n; backward is direction of lookbehind
+func compile(n node, backward bool) matcher {
+	switch n := n.(type) {
+	case *seqNode:
+		ms := make([]matcher, len(n.items))
+		for i, item := range n.items {
+			if backward {
+				ms[len(ms)-1-i] = compile(item, backward)
+			} else {
+				ms[i] = compile(item, backward)
+			}
+		}
+		return sequence(ms)
+	case *altNode:
+		ms := make([]matcher, len(n.alts))
+		for i, alt := range n.alts {
+			ms[i] = compile(alt, backward)
+		}
+		return func(m *machine, pos int, k cont) bool {
+			for _, alt := range ms {
+				m.step()
+				if alt(m, pos, k) {
+					return true
+				}
+			}
+			return false
+		}
+	case *charNode:
+		return compileChar(n, backward)
+	case *dotNode:
+		return func(m *machine, pos int, k cont) bool {
+			m.step()
+			c, next, ok := m.read(pos, backward)
+			if !ok || !m.dot && isLineTerminator(c) {
+				return false
+			}
+			return k(next)
+		}
+	case *anchorNode:
+		return compileAnchor(n)
+	case *groupNode:
+		return compileGroup(n, backward)
+	case *backrefNode:
+		return compileBackref(n, backward)
+	case *lookNode:
+		return compileLook(n)
+	case *repeatNode:
+		return compileRepeat(n, backward)
+	}
+	return nil
+}
+
+func sequence(ms []matcher) matcher {
+	switch len(ms) {
+	case 0:
+		return func(m *machine, pos int, k cont) bool { return k(pos) }
+	case 1:
+		return ms[0]
+	}
+	first, rest := ms[0], sequence(ms[1:])
+	return func(m *machine, pos int, k cont) bool {
+		return first(m, pos, func(p int) bool { return rest(m, p, k) })
+	}
+}
+
+// matchChar match one char of n at pos & return next pos
+func (m *machine) matchChar(n *charNode, pos int, backward bool) (int, bool) {
+	c, next, ok := m.read(pos, backward)
+	if !ok || m.has(n.set, c) == n.invert {
+		return pos, false
+	}
+	return next, true
+}
+
+func compileChar(n *charNode, backward bool) matcher {
+	if len(n.set.strs) == 0 {
+		return func(m *machine, pos int, k cont) bool {
+			m.step()
+			next, ok := m.matchChar(n, pos, backward)
+			return ok && k(next)
+		}
+	}
+	return func(m *machine, pos int, k cont) bool {
+		m.step()
+		var empty bool
+		for _, s := range n.set.strs {
+			if len(s) == 0 {
+				empty = true
+				continue
+			}
+			if next, ok := m.matchString(s, pos, backward); ok && k(next) {
+				return true
+			}
+		}
+		if next, ok := m.matchChar(n, pos, backward); ok && k(next) {
+			return true
+		}
+		return empty && k(pos)
+	}
+}
+
+// matchString match chars of s at pos & return next pos
+func (m *machine) matchString(s []rune, pos int, backward bool) (int, bool) {
+	for i := range s {
+		r := s[i]
+		if backward {
+			r = s[len(s)-1-i]
+		}
+		c, next, ok := m.read(pos, backward)
+		if !ok || !m.equal(c, r) {
+			return pos, false
+		}
+		pos = next
+	}
+	return pos, true
+}
+
+func compileAnchor(n *anchorNode) matcher {
+	return func(m *machine, pos int, k cont) bool {
+		m.step()
+		var ok bool
+		switch n.kind {
+		case anchorBegin:
+			ok = pos == 0 || m.multiline && isLineTerminator(rune(m.in[pos-1]))
+		case anchorEnd:
+			ok = pos == len(m.in) || m.multiline && isLineTerminator(rune(m.in[pos]))
+		case anchorWordBoundary:
+			ok = m.isWord(pos-1) != m.isWord(pos)
+		case anchorNotWordBoundary:
+			ok = m.isWord(pos-1) == m.isWord(pos)
+		}
+		return ok && k(pos)
+	}
+}
+
+func compileGroup(n *groupNode, backward bool) matcher {
+	body := compile(n.n, backward)
+	i := n.index * 2
+	return func(m *machine, pos int, k cont) bool {
+		return body(m, pos, func(p int) bool {
+			start, end := m.caps[i], m.caps[i+1]
+			if backward {
+				m.caps[i], m.caps[i+1] = p, pos
+			} else {
+				m.caps[i], m.caps[i+1] = pos, p
+			}
+			if k(p) {
+				return true
+			}
+			m.caps[i], m.caps[i+1] = start, end
+			return false
+		})
+	}
+}
+
+func compileBackref(n *backrefNode, backward bool) matcher {
+	return func(m *machine, pos int, k cont) bool {
+		m.step()
+		start, end := m.caps[n.index*2], m.caps[n.index*2+1]
+		if start < 0 {
+			return k(pos)
+		}
+		ref := &machine{in: m.in[start:end], unicode: m.unicode}
+		p, i := pos, 0
+		if backward {
+			i = len(ref.in)
+		}
+		for {
+			r, next, ok := ref.read(i, backward)
+			if !ok {
+				break
+			}
+			i = next
+			c, after, ok := m.read(p, backward)
+			if !ok || !m.equal(c, r) {
+				return false
+			}
+			p = after
+		}
+		return k(p)
+	}
+}
+
+func compileLook(n *lookNode) matcher {
+	body := compile(n.n, n.behind)
+	return func(m *machine, pos int, k cont) bool {
+		m.step()
+		saved := append([]int(nil), m.caps...)
+		matched := body(m, pos, func(int) bool { return true })
+		if matched == n.negate {
+			copy(m.caps, saved)
+			return false
+		}
+		if n.negate {
+			copy(m.caps, saved)
+		}
+		if k(pos) {
+			return true
+		}
+		copy(m.caps, saved)
+		return false
+	}
+}
+
+func compileRepeat(n *repeatNode, backward bool) matcher {
+	if c, ok := n.n.(*charNode); ok && len(c.set.strs) == 0 {
+		return compileCharRepeat(n, c, backward)
+	}
+
+	body := compile(n.n, backward)
+	capStart, capEnd := n.capFirst*2+2, n.capLast*2+2
+	var rep func(m *machine, pos, count int, k cont) bool
+	rep = func(m *machine, pos, count int, k cont) bool {
+		m.step()
+		if n.max >= 0 && count >= n.max {
+			return k(pos)
+		}
+		iterate := func() bool {
+			saved := append([]int(nil), m.caps[capStart:capEnd]...)
+			for i := capStart; i < capEnd; i++ {
+				m.caps[i] = -1
+			}
+			if body(m, pos, func(p int) bool {
+				if p == pos && count >= n.min {
+					return false
+				}
+				return rep(m, p, count+1, k)
+			}) {
+				return true
+			}
+			copy(m.caps[capStart:capEnd], saved)
+			return false
+		}
+		if count < n.min {
+			return iterate()
+		}
+		if n.greedy {
+			return iterate() || k(pos)
+		}
+		return k(pos) || iterate()
+	}
+	return func(m *machine, pos int, k cont) bool {
+		return rep(m, pos, 0, k)
+	}
+}
+
+// compileCharRepeat return matcher of quantified char without recursion for each char
+func compileCharRepeat(n *repeatNode, c *charNode, backward bool) matcher {
+	return func(m *machine, pos int, k cont) bool {
+		if !n.greedy {
+			for count := 0; ; count++ {
+				m.step()
+				if count >= n.min && k(pos) {
+					return true
+				}
+				if n.max >= 0 && count >= n.max {
+					return false
+				}
+				next, ok := m.matchChar(c, pos, backward)
+				if !ok {
+					return false
+				}
+				pos = next
+			}
+		}
+
+		positions := []int{pos}
+		for n.max < 0 || len(positions)-1 < n.max {
+			m.step()
+			next, ok := m.matchChar(c, pos, backward)
+			if !ok {
+				break
+			}
+			pos = next
+			positions = append(positions, pos)
+		}
+		for i := len(positions) - 1; i >= n.min; i-- {
+			m.step()
+			if k(positions[i]) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,259 @@
+package regexp
+
+import (
+	"strconv"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// Match return matches of re in s, like js match
+// 	without g flag return Array of Exec; with g flag return Array of all matched strings
+// 	return nil if there is no match
+func (re *RegExp) Match(s string) (*array.Array, error) {
+	if !re.global {
+		m, err := re.Exec(s)
+		if m == nil {
+			return nil, err
+		}
+		return m.Array, nil
+	}
+
+	in := jsstring.New(s)
+	r := array.NewArray()
+	re.LastIndex = 0
+	for {
+		caps, err := re.exec(in)
+		if err != nil {
+			return nil, err
+		}
+		if caps == nil {
+			break
+		}
+		r.Push(in[caps[0]:caps[1]].String())
+		re.emptyAdvance(in, caps)
+	}
+	if len(r.Items) == 0 {
+		return nil, nil
+	}
+	return r, nil
+}
+
+// emptyAdvance move LastIndex forward after empty match, so loop of matches can't stuck
+func (re *RegExp) emptyAdvance(in jsstring.JSString, caps []int) {
+	if caps[0] == caps[1] {
+		re.LastIndex = advance(in, re.LastIndex, re.fullUnicode())
+	}
+}
+
+// MatchIterator is iterator of MatchAll, usable as array.Iterator
+type MatchIterator struct {
+	re   *RegExp
+	in   jsstring.JSString
+	s    string
+	done bool
+	err  error
+}
+
+// MatchAll return iterator of all matches of re in s, like js matchAll
+// 	iterator uses copy of re starting from LastIndex, so re is not changed
+// 	return array.TypeError if re has no g flag
+func (re *RegExp) MatchAll(s string) (*MatchIterator, error) {
+	if !re.global {
+		return nil, &array.TypeError{Message: "String.prototype.matchAll called with a non-global RegExp argument"}
+	}
+	clone := *re
+	return &MatchIterator{re: &clone, in: jsstring.New(s), s: s}, nil
+}
+
+// Next return next *Match
+func (it *MatchIterator) Next() (interface{}, bool) {
+	if it.done {
+		return nil, true
+	}
+	caps, err := it.re.exec(it.in)
+	if caps == nil {
+		it.done, it.err = true, err
+		return nil, true
+	}
+	it.re.emptyAdvance(it.in, caps)
+	return it.re.match(it.in, it.s, caps), false
+}
+
+// Err return error which stopped iteration or nil
+func (it *MatchIterator) Err() error {
+	return it.err
+}
+
+// Replace return s with matches of re replaced, like js replace
+// 	with g flag all matches are replaced, otherwise first one
+// 	replacement patterns: $$ is "$", $& is matched string, $` is part before match,
+// 	$' is part after match, $n & $nn are groups, $<name> is named group
+func (re *RegExp) Replace(s, replacement string) (string, error) {
+	rep := jsstring.New(replacement)
+	return re.replace(s, func(in jsstring.JSString, caps []int) jsstring.JSString {
+		return re.expand(in, caps, rep)
+	})
+}
+
+// ReplaceFunc return s with matches of re replaced by result of fn, like js replace with function
+func (re *RegExp) ReplaceFunc(s string, fn func(m *Match) string) (string, error) {
+	return re.replace(s, func(in jsstring.JSString, caps []int) jsstring.JSString {
+		return jsstring.New(fn(re.match(in, s, caps)))
+	})
+}
+
+func (re *RegExp) replace(s string, replacer func(in jsstring.JSString, caps []int) jsstring.JSString) (string, error) {
+	in := jsstring.New(s)
+	var results [][]int
+	if re.global {
+		re.LastIndex = 0
+	}
+	for {
+		caps, err := re.exec(in)
+		if err != nil {
+			return "", err
+		}
+		if caps == nil {
+			break
+		}
+		results = append(results, append([]int(nil), caps...))
+		if !re.global {
+			break
+		}
+		re.emptyAdvance(in, caps)
+	}
+
+	r := jsstring.JSString{}
+	next := 0
+	for _, caps := range results {
+		if caps[0] < next {
+			continue
+		}
+		r = append(r, in[next:caps[0]]...)
+		r = append(r, replacer(in, caps)...)
+		next = caps[1]
+	}
+	return append(r, in[next:]...).String(), nil
+}
+
+// expand return replacement with patterns replaced by match parts, like js GetSubstitution
+func (re *RegExp) expand(in jsstring.JSString, caps []int, rep jsstring.JSString) jsstring.JSString {
+	group := func(i int) jsstring.JSString {
+		if caps[i*2] < 0 {
+			return nil
+		}
+		return in[caps[i*2]:caps[i*2+1]]
+	}
+
+	r := jsstring.JSString{}
+	for i := 0; i < len(rep); i++ {
+		if rep[i] != '$' || i+1 >= len(rep) {
+			r = append(r, rep[i])
+			continue
+		}
+		switch c := rep[i+1]; {
+		case c == '$':
+			r = append(r, '$')
+			i++
+		case c == '&':
+			r = append(r, in[caps[0]:caps[1]]...)
+			i++
+		case c == '`':
+			r = append(r, in[:caps[0]]...)
+			i++
+		case c == '\'':
+			r = append(r, in[caps[1]:]...)
+			i++
+		case c >= '0' && c <= '9':
+			n, size := re.groupNumber(rep[i+1:])
+			if size == 0 {
+				r = append(r, '$')
+				continue
+			}
+			r = append(r, group(n)...)
+			i += size
+		case c == '<' && re.names != nil:
+			end := rep[i+2:].Index(jsstring.New(">"), 0)
+			if end < 0 {
+				r = append(r, '$')
+				continue
+			}
+			if index, ok := re.names[rep[i+2:i+2+end].String()]; ok {
+				r = append(r, group(index)...)
+			}
+			i += end + 2
+		default:
+			r = append(r, '$')
+		}
+	}
+	return r
+}
+
+// groupNumber parse group number of $n or $nn; size is 0 if there is no such group
+func (re *RegExp) groupNumber(digits jsstring.JSString) (n, size int) {
+	if len(digits) >= 2 && digits[1] >= '0' && digits[1] <= '9' {
+		if n, _ := strconv.Atoi(string(rune(digits[0])) + string(rune(digits[1]))); n >= 1 && n <= re.ncap {
+			return n, 2
+		}
+	}
+	if n := int(digits[0] - '0'); n >= 1 && n <= re.ncap {
+		return n, 1
+	}
+	return 0, 0
+}
+
+// Split return Array of s parts divided by matches of re, like js split
+// 	captured groups are added between parts; if limit >= 0, array has at most limit elements
+// 	g & y flags & LastIndex are ignored
+func (re *RegExp) Split(s string, limit int) (*array.Array, error) {
+	r := array.NewArray()
+	if limit == 0 {
+		return r, nil
+	}
+	in := jsstring.New(s)
+	full := func() bool {
+		return limit > 0 && len(r.Items) >= limit
+	}
+
+	if len(in) == 0 {
+		caps, err := re.run(in, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		if caps == nil {
+			r.Push(s)
+		}
+		return r, nil
+	}
+
+	p := 0
+	for q := 0; q < len(in); {
+		caps, err := re.run(in, q, true)
+		if err != nil {
+			return nil, err
+		}
+		if caps == nil || caps[1] == p {
+			q = advance(in, q, re.fullUnicode())
+			continue
+		}
+		r.Push(in[p:q].String())
+		if full() {
+			return r, nil
+		}
+		p = caps[1]
+		for i := 1; i <= re.ncap; i++ {
+			if caps[i*2] < 0 {
+				r.Push(nil)
+			} else {
+				r.Push(in[caps[i*2]:caps[i*2+1]].String())
+			}
+			if full() {
+				return r, nil
+			}
+		}
+		q = p
+	}
+	r.Push(in[p:].String())
+	return r, nil
+}
@@ -0,0 +1,88 @@
+package regexp
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestRegExpMatch(t *testing.T) {
+	tests := []struct {
+		pattern, flags, s string
+		want              *array.Array
+		description       string
+	}{
+		{pattern: `a|`, flags: "g", s: "baab", want: array.MakeArray("", "a", "a", "", ""), description: "empty matches"},
+		{pattern: `(\d)`, flags: "", s: "a1b2", want: array.MakeArray("1", "1"), description: "not global"},
+		{pattern: `\d`, flags: "g", s: "ab", description: "no match"},
+		{pattern: ``, flags: "gu", s: "\U0001F600", want: array.MakeArray("", ""), description: "unicode advance"},
+	}
+
+	for _, tt := range tests {
+		got, _ := MustCompile(tt.pattern, tt.flags).Match(tt.s)
+		TestLog("Match", t, tt.pattern, got, tt.want, tt.description)
+	}
+}
+
+func TestRegExpMatchAll(t *testing.T) {
+	_, err := MustCompile(`a`, "").MatchAll("a")
+	_, ok := err.(*array.TypeError)
+	TestLog("MatchAll", t, "a", ok, true, "not global")
+
+	re := MustCompile(`\d(?<x>\w)?`, "g")
+	it, _ := re.MatchAll("1a2")
+	var got []interface{}
+	for _, v := range array.FromIterator(it).Items {
+		m := v.Data.(*Match)
+		got = append(got, m.Index, m.Groups["x"])
+	}
+	TestLog("MatchAll", t, "1a2", got, []interface{}{0, "a", 2, nil}, "all matches")
+	TestLog("MatchAll", t, "1a2", re.LastIndex, 0, "LastIndex of re isn't changed")
+	TestLog("MatchAll", t, "1a2", it.Err(), nil, "no error")
+}
+
+func TestRegExpReplace(t *testing.T) {
+	tests := []struct {
+		pattern, flags, s, replacement string
+		want                           string
+		description                    string
+	}{
+		{pattern: `(\d)(\d)?`, flags: "g", s: "a12b3", replacement: "[$2$1$&$$$3]", want: "a[2112$$3]b[33$$3]", description: "numbered groups"},
+		{pattern: `(?<a>\w)`, flags: "g", s: "ab", replacement: "$<a>$<a>$<b", want: "aa$<bbb$<b", description: "named groups"},
+		{pattern: `b`, s: "abc", replacement: "[$`|$']", want: "a[a|c]c", description: "before & after"},
+		{pattern: `(a)`, s: "aa", replacement: "$01$10", want: "aa0a", description: "two digits group"},
+		{pattern: `x*`, flags: "g", s: "ab", replacement: "-", want: "-a-b-", description: "empty matches"},
+		{pattern: `(?<x>a)`, s: "a", replacement: "$<y>", want: "", description: "unknown name is empty"},
+	}
+
+	for _, tt := range tests {
+		got, _ := MustCompile(tt.pattern, tt.flags).Replace(tt.s, tt.replacement)
+		TestLog("Replace", t, tt.pattern, got, tt.want, tt.description)
+	}
+
+	got, _ := MustCompile(`\d+`, "g").ReplaceFunc("a1b22", func(m *Match) string {
+		return m.Items[0].Data.(string) + "!"
+	})
+	TestLog("ReplaceFunc", t, "a1b22", got, "a1!b22!", "function replacement")
+}
+
+func TestRegExpSplit(t *testing.T) {
+	tests := []struct {
+		pattern, flags, s string
+		limit             int
+		want              *array.Array
+		description       string
+	}{
+		{pattern: `(-)|,`, s: "a,b-c", limit: -1, want: array.MakeArray("a", nil, "b", "-", "c"), description: "captures are added"},
+		{pattern: ``, s: "ab", limit: -1, want: array.MakeArray("a", "b"), description: "empty separator"},
+		{pattern: `x*`, flags: "u", s: "a\U0001F600", limit: -1, want: array.MakeArray("a", "\U0001F600"), description: "unicode split"},
+		{pattern: `,`, s: "a,b,c", limit: 2, want: array.MakeArray("a", "b"), description: "limit"},
+		{pattern: `a?`, s: "", limit: -1, want: array.NewArray(), description: "empty string matched"},
+		{pattern: `a`, s: "", limit: -1, want: array.MakeArray(""), description: "empty string not matched"},
+	}
+
+	for _, tt := range tests {
+		got, _ := MustCompile(tt.pattern, tt.flags).Split(tt.s, tt.limit)
+		TestLog("Split", t, tt.pattern, got, tt.want, tt.description)
+	}
+}
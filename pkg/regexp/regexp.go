@@ -0,0 +1,316 @@
+package regexp
+
+import (
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// DefaultStepLimit is maximum of backtracking steps of one Exec if RegExp.StepLimit is not set
+var DefaultStepLimit = 10000000
+
+// RegExp is js regular expression with ECMAScript syntax & semantics
+// 	all indexes are in UTF-16 code units, like in js
+// 	RegExp with g or y flag keep state in LastIndex, so it is not safe for concurrent use
+type RegExp struct {
+	// LastIndex is index where next match starts for g & y flags, like js lastIndex
+	LastIndex int
+	// StepLimit is maximum of backtracking steps of one Exec; if <= 0, DefaultStepLimit is used
+	StepLimit int
+
+	source string
+	flags  string
+
+	hasIndices, global, ignoreCase, multiline, dotAll, unicode, unicodeSets, sticky bool
+
+	prog  matcher
+	ncap  int
+	names map[string]int
+}
+
+// New return compiled RegExp of pattern with flags, like js new RegExp(pattern, flags)
+// 	flags are: d (indices), g (global), i (ignore case), m (multiline), s (dotAll),
+// 	u (unicode), v (unicode sets), y (sticky)
+// 	return array.SyntaxError for invalid pattern or flags
+func New(pattern, flags string) (*RegExp, error) {
+	re := &RegExp{source: pattern}
+	for _, f := range flags {
+		var flag *bool
+		switch f {
+		case 'd':
+			flag = &re.hasIndices
+		case 'g':
+			flag = &re.global
+		case 'i':
+			flag = &re.ignoreCase
+		case 'm':
+			flag = &re.multiline
+		case 's':
+			flag = &re.dotAll
+		case 'u':
+			flag = &re.unicode
+		case 'v':
+			flag = &re.unicodeSets
+		case 'y':
+			flag = &re.sticky
+		}
+		if flag == nil || *flag {
+			return nil, &array.SyntaxError{Message: "Invalid flags supplied to RegExp constructor '" + flags + "'"}
+		}
+		*flag = true
+	}
+	if re.unicode && re.unicodeSets {
+		return nil, &array.SyntaxError{Message: "Invalid flags supplied to RegExp constructor '" + flags + "'"}
+	}
+	for i, f := range []bool{re.hasIndices, re.global, re.ignoreCase, re.multiline, re.dotAll, re.unicode, re.unicodeSets, re.sticky} {
+		if f {
+			re.flags += string("dgimsuvy"[i])
+		}
+	}
+
+	n, ncap, names, err := parse(pattern, re.unicode || re.unicodeSets, re.unicodeSets, re.ignoreCase)
+	if err != nil {
+		err.(*array.SyntaxError).Message = "Invalid regular expression: /" + re.Source() + "/" + re.flags + ": " + err.(*array.SyntaxError).Message
+		return nil, err
+	}
+	re.prog, re.ncap, re.names = compile(n, false), ncap, names
+	return re, nil
+}
+
+// MustCompile is like New but panics if pattern or flags are invalid
+func MustCompile(pattern, flags string) *RegExp {
+	re, err := New(pattern, flags)
+	if err != nil {
+		panic(err)
+	}
+	return re
+}
+
+// Source return pattern with escaped "/" & line terminators, like js source
+func (re *RegExp) Source() string {
+	if re.source == "" {
+		return "(?:)"
+	}
+	var b strings.Builder
+	inClass := false
+	for i, r := range re.source {
+		switch {
+		case r == '/' && !inClass && !escaped(re.source, i):
+			b.WriteString(`\/`)
+			continue
+		case r == '[' && !escaped(re.source, i):
+			inClass = true
+		case r == ']' && !escaped(re.source, i):
+			inClass = false
+		case r == '\n':
+			b.WriteString(`\n`)
+			continue
+		case r == '\r':
+			b.WriteString(`\r`)
+			continue
+		case r == 0x2028:
+			b.WriteString(`\u2028`)
+			continue
+		case r == 0x2029:
+			b.WriteString(`\u2029`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// escaped check is byte at i preceded by odd count of "\"
+func escaped(s string, i int) bool {
+	n := 0
+	for i--; i >= 0 && s[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// Flags return flags in order "dgimsuvy", like js flags
+func (re *RegExp) Flags() string {
+	return re.flags
+}
+
+// HasIndices check is d flag set
+func (re *RegExp) HasIndices() bool {
+	return re.hasIndices
+}
+
+// Global check is g flag set
+func (re *RegExp) Global() bool {
+	return re.global
+}
+
+// IgnoreCase check is i flag set
+func (re *RegExp) IgnoreCase() bool {
+	return re.ignoreCase
+}
+
+// Multiline check is m flag set
+func (re *RegExp) Multiline() bool {
+	return re.multiline
+}
+
+// DotAll check is s flag set
+func (re *RegExp) DotAll() bool {
+	return re.dotAll
+}
+
+// Unicode check is u flag set
+func (re *RegExp) Unicode() bool {
+	return re.unicode
+}
+
+// UnicodeSets check is v flag set
+func (re *RegExp) UnicodeSets() bool {
+	return re.unicodeSets
+}
+
+// Sticky check is y flag set
+func (re *RegExp) Sticky() bool {
+	return re.sticky
+}
+
+// String return js literal of re, like /source/flags
+func (re *RegExp) String() string {
+	return "/" + re.Source() + "/" + re.flags
+}
+
+// fullUnicode check is input read by code points
+func (re *RegExp) fullUnicode() bool {
+	return re.unicode || re.unicodeSets
+}
+
+// Match is result of Exec, like js exec result
+// 	Array items are matched string & captured groups; group which didn't participate is nil
+type Match struct {
+	*array.Array
+	// Index is start of match
+	Index int
+	// Input is string where match was found
+	Input string
+	// Groups are named groups values or nil if pattern has no named groups
+	Groups map[string]interface{}
+	// Indices are [start, end] pairs of match & groups for d flag; nil for group which didn't participate
+	Indices [][]int
+	// IndexGroups are Indices of named groups for d flag
+	IndexGroups map[string][]int
+}
+
+// Exec return next match in s or nil if there is no match, like js exec
+// 	with g or y flag search starts at LastIndex & LastIndex is set after match or to 0 on failure
+// 	return ErrStepLimit if match take too many backtracking steps
+func (re *RegExp) Exec(s string) (*Match, error) {
+	in := jsstring.New(s)
+	caps, err := re.exec(in)
+	if caps == nil {
+		return nil, err
+	}
+	return re.match(in, s, caps), nil
+}
+
+// Test check is there match in s, like js test
+func (re *RegExp) Test(s string) (bool, error) {
+	caps, err := re.exec(jsstring.New(s))
+	return caps != nil, err
+}
+
+// exec return captures of next match respecting LastIndex, like js RegExpBuiltinExec
+func (re *RegExp) exec(in jsstring.JSString) ([]int, error) {
+	start := 0
+	if re.global || re.sticky {
+		start = re.LastIndex
+		if start < 0 {
+			start = 0
+		}
+	}
+	var caps []int
+	var err error
+	if start <= len(in) {
+		caps, err = re.run(in, start, re.sticky)
+	}
+	if re.global || re.sticky {
+		re.LastIndex = 0
+		if caps != nil {
+			re.LastIndex = caps[1]
+		}
+	}
+	return caps, err
+}
+
+// run return captures of first match starting from start or only at start for sticky
+// 	captures are start & end pairs, -1 for group which didn't participate
+func (re *RegExp) run(in jsstring.JSString, start int, sticky bool) (caps []int, err error) {
+	m := &machine{
+		in:         in,
+		unicode:    re.fullUnicode(),
+		ignoreCase: re.ignoreCase,
+		multiline:  re.multiline,
+		dot:        re.dotAll,
+		caps:       make([]int, re.ncap*2+2),
+		limit:      re.StepLimit,
+	}
+	if m.limit <= 0 {
+		m.limit = DefaultStepLimit
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(stepLimitPanic); !ok {
+				panic(r)
+			}
+			caps, err = nil, ErrStepLimit
+		}
+	}()
+
+	for i := start; i <= len(in); i = advance(in, i, m.unicode) {
+		for j := range m.caps {
+			m.caps[j] = -1
+		}
+		if re.prog(m, i, func(end int) bool {
+			m.caps[0], m.caps[1] = i, end
+			return true
+		}) {
+			return m.caps, nil
+		}
+		if sticky {
+			break
+		}
+	}
+	return nil, nil
+}
+
+// match return Match of captures
+func (re *RegExp) match(in jsstring.JSString, s string, caps []int) *Match {
+	m := &Match{Array: array.NewArray(), Index: caps[0], Input: s}
+	if re.hasIndices {
+		m.Indices = make([][]int, re.ncap+1)
+	}
+	for i := 0; i <= re.ncap; i++ {
+		start, end := caps[i*2], caps[i*2+1]
+		if start < 0 {
+			m.Push(nil)
+			continue
+		}
+		m.Push(in[start:end].String())
+		if re.hasIndices {
+			m.Indices[i] = []int{start, end}
+		}
+	}
+	if re.names != nil {
+		m.Groups = map[string]interface{}{}
+		if re.hasIndices {
+			m.IndexGroups = map[string][]int{}
+		}
+		for name, i := range re.names {
+			m.Groups[name] = m.Items[i].Data
+			if re.hasIndices {
+				m.IndexGroups[name] = m.Indices[i]
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,137 @@
+package regexp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestRegExpNew(t *testing.T) {
+	tests := []struct {
+		pattern, flags string
+		want           string
+		wantErr        bool
+		description    string
+	}{
+		{pattern: "a/b", flags: "ygi", want: `/a\/b/giy`, description: "flags order & escaped slash"},
+		{pattern: "[/]\n", flags: "", want: `/[/]\n/`, description: "slash in class & line terminator"},
+		{pattern: "", flags: "", want: "/(?:)/", description: "empty pattern"},
+		{pattern: "a", flags: "gg", wantErr: true, description: "duplicate flag"},
+		{pattern: "a", flags: "uv", wantErr: true, description: "u & v flags"},
+		{pattern: "a", flags: "x", wantErr: true, description: "unknown flag"},
+		{pattern: "(a", flags: "", wantErr: true, description: "unterminated group"},
+	}
+
+	for _, tt := range tests {
+		re, err := New(tt.pattern, tt.flags)
+		if tt.wantErr {
+			_, ok := err.(*array.SyntaxError)
+			TestLog("New", t, tt.pattern, ok, true, tt.description)
+			continue
+		}
+		TestLog("New", t, tt.pattern, re.String(), tt.want, tt.description)
+	}
+}
+
+func TestRegExpExec(t *testing.T) {
+	tests := []struct {
+		pattern, flags, s string
+		want              *array.Array
+		index             int
+		description       string
+	}{
+		{pattern: `(?<=\$)\d+(\.\d*)?`, s: "cost $10.53", want: array.MakeArray("10.53", ".53"), index: 6, description: "lookbehind"},
+		{pattern: `(?<!\$)\b\d+`, s: "$10 and 42", want: array.MakeArray("42"), index: 8, description: "negative lookbehind"},
+		{pattern: `(?<=(\d+)(\d+))$`, s: "1053", want: array.MakeArray("", "1", "053"), index: 4, description: "lookbehind captures are greedy from right"},
+		{pattern: `(?=(a+))a*b\1`, s: "baaabac", want: array.MakeArray("aba", "a"), index: 3, description: "lookahead capture & backreference"},
+		{pattern: `(a)|b`, s: "b", want: array.MakeArray("b", nil), description: "not participated group is nil"},
+		{pattern: `(z)((a+)?(b+)?(c))*`, s: "zaacbbbcac", want: array.MakeArray("zaacbbbcac", "z", "ac", "a", nil, "c"), description: "captures reset on each iteration"},
+		{pattern: `(a*)*`, s: "b", want: array.MakeArray("", nil), description: "empty iteration is rejected"},
+		{pattern: `(\w)\1`, s: "abccd", want: array.MakeArray("cc", "c"), index: 2, description: "backreference"},
+		{pattern: `\k<x>(?<x>a)`, s: "aa", want: array.MakeArray("a", "a"), description: "forward named reference is empty"},
+		{pattern: `a{2,3}?`, s: "aaaa", want: array.MakeArray("aa"), description: "lazy quantifier"},
+		{pattern: `^b`, flags: "m", s: "a\nb", want: array.MakeArray("b"), index: 2, description: "multiline"},
+		{pattern: `a.c`, flags: "s", s: "a\nc", want: array.MakeArray("a\nc"), description: "dotAll"},
+		{pattern: `a.c`, s: "a\nc", description: "dot doesn't match line terminator"},
+		{pattern: `.`, s: "\U0001F600", want: array.MakeArray("\xed\xa0\xbd"), description: "dot match code unit"},
+		{pattern: `^.$`, flags: "u", s: "\U0001F600", want: array.MakeArray("\U0001F600"), description: "dot match code point in unicode mode"},
+		{pattern: `\u{1F600}`, flags: "u", s: "x\U0001F600", want: array.MakeArray("\U0001F600"), index: 1, description: "index in code units"},
+		{pattern: `\p{Script=Greek}+`, flags: "u", s: "abc αβ", want: array.MakeArray("αβ"), index: 4, description: "unicode property"},
+		{pattern: `[\p{L}--[a-z]]+`, flags: "v", s: "abcDEF", want: array.MakeArray("DEF"), index: 3, description: "class subtraction"},
+		{pattern: `[\q{abc|d}x]+`, flags: "v", s: "zabcdx", want: array.MakeArray("abcdx"), index: 1, description: "class strings"},
+		{pattern: `ſ`, flags: "i", s: "S", description: "non unicode case folding"},
+		{pattern: `ſ`, flags: "iu", s: "S", want: array.MakeArray("S"), description: "unicode case folding"},
+		{pattern: `[^a]`, flags: "i", s: "A", description: "negated class ignore case"},
+		{pattern: `\101{`, s: "A{", want: array.MakeArray("A{"), description: "legacy octal & literal brace"},
+	}
+
+	for _, tt := range tests {
+		m, err := MustCompile(tt.pattern, tt.flags).Exec(tt.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.want == nil {
+			TestLog("Exec", t, tt.pattern, m, (*Match)(nil), tt.description)
+			continue
+		}
+		if m == nil {
+			t.Errorf("Exec:(%v) = nil. Test: %v", tt.pattern, tt.description)
+			continue
+		}
+		TestLog("Exec", t, tt.pattern, m.Array, tt.want, tt.description)
+		TestLog("Exec", t, tt.pattern, m.Index, tt.index, tt.description+" index")
+	}
+}
+
+func TestRegExpGroups(t *testing.T) {
+	m, _ := MustCompile(`(?<y>\d{4})-(?<m>\d\d)(x)?`, "d").Exec("on 2024-05-01")
+	TestLog("Exec", t, "groups", m.Groups, map[string]interface{}{"y": "2024", "m": "05"}, "named groups")
+	TestLog("Exec", t, "indices", m.Indices, [][]int{{3, 10}, {3, 7}, {8, 10}, nil}, "indices")
+	TestLog("Exec", t, "indices", m.IndexGroups, map[string][]int{"y": {3, 7}, "m": {8, 10}}, "indices groups")
+
+	m, _ = MustCompile(`(a)`, "").Exec("a")
+	TestLog("Exec", t, "groups", m.Groups, map[string]interface{}(nil), "no named groups")
+	TestLog("Exec", t, "indices", m.Indices, [][]int(nil), "no d flag")
+}
+
+func TestRegExpLastIndex(t *testing.T) {
+	re := MustCompile(`a`, "g")
+	for _, want := range []int{1, 3, 0} {
+		re.Exec("aba")
+		TestLog("LastIndex", t, "aba", re.LastIndex, want, "global exec")
+	}
+
+	re = MustCompile(`a`, "y")
+	ok, _ := re.Test("ba")
+	TestLog("LastIndex", t, "ba", ok, false, "sticky don't search")
+	re.LastIndex = 1
+	ok, _ = re.Test("ba")
+	TestLog("LastIndex", t, "ba", ok, true, "sticky match at LastIndex")
+	TestLog("LastIndex", t, "ba", re.LastIndex, 2, "sticky LastIndex")
+
+	re = MustCompile(`a`, "")
+	re.LastIndex = 5
+	ok, _ = re.Test("a")
+	TestLog("LastIndex", t, "a", ok, true, "LastIndex ignored without g & y")
+}
+
+func TestRegExpStepLimit(t *testing.T) {
+	re := MustCompile(`(a+)+$`, "")
+	re.StepLimit = 100000
+	_, err := re.Exec("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaa!")
+	TestLog("StepLimit", t, re, err, ErrStepLimit, "catastrophic backtracking")
+
+	ok, err := re.Test("aaaa")
+	TestLog("StepLimit", t, re, ok, true, "match in limit")
+	TestLog("StepLimit", t, re, err, nil, "no error in limit")
+}
@@ -0,0 +1,791 @@
+package regexp
+
+import (
+	"strconv"
+	"unicode"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// node is part of parsed pattern
+type node interface{}
+
+// altNode is disjunction a|b
+type altNode struct {
+	alts []node
+}
+
+// seqNode is alternative: terms matched one by one
+type seqNode struct {
+	items []node
+}
+
+// charNode match one char of set; invert is for non v mode [^...] classes
+type charNode struct {
+	set    *charSet
+	invert bool
+}
+
+// dotNode is .
+type dotNode struct{}
+
+type anchorKind int
+
+const (
+	anchorBegin anchorKind = iota
+	anchorEnd
+	anchorWordBoundary
+	anchorNotWordBoundary
+)
+
+// anchorNode is assertion ^, $, \b or \B
+type anchorNode struct {
+	kind anchorKind
+}
+
+// lookNode is lookahead or lookbehind assertion
+type lookNode struct {
+	behind, negate bool
+	n              node
+}
+
+// groupNode is capturing group
+type groupNode struct {
+	index int
+	n     node
+}
+
+// backrefNode is \1 or \k<name>
+type backrefNode struct {
+	index int
+	name  string
+}
+
+// repeatNode is quantified atom; max < 0 means no limit
+// 	captures capFirst+1..capLast are inside of atom and are reset on each iteration
+type repeatNode struct {
+	n                 node
+	min, max          int
+	greedy            bool
+	capFirst, capLast int
+}
+
+// parser is parser of pattern
+// 	in unicode mode pattern is read by code points, otherwise by code units
+type parser struct {
+	src        []rune
+	pos        int
+	unicode    bool
+	sets       bool
+	ignoreCase bool
+
+	ncap     int
+	total    int
+	hasNames bool
+	names    map[string]int
+	refs     []*backrefNode
+}
+
+// syntaxPanic is used to unwind parser on first error
+type syntaxPanic struct {
+	message string
+}
+
+func (p *parser) fail(message string) {
+	panic(syntaxPanic{message})
+}
+
+// parse return parsed pattern, count of capturing groups & names of named groups
+func parse(pattern string, unicodeMode, sets, ignoreCase bool) (n node, ncap int, names map[string]int, err error) {
+	p := &parser{unicode: unicodeMode, sets: sets, ignoreCase: ignoreCase, names: map[string]int{}}
+	u := jsstring.New(pattern)
+	for i := 0; i < len(u); i++ {
+		c := rune(u[i])
+		if unicodeMode {
+			c, _ = u.CodePointAt(i)
+			if c > 0xffff {
+				i++
+			}
+		}
+		p.src = append(p.src, c)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			sp, ok := r.(syntaxPanic)
+			if !ok {
+				panic(r)
+			}
+			err = &array.SyntaxError{Message: sp.message}
+		}
+	}()
+
+	p.prescan()
+	n = p.disjunction()
+	if !p.end() {
+		if p.peek() == ')' {
+			p.fail("Unmatched ')'")
+		}
+		p.fail("Unexpected character")
+	}
+	for _, r := range p.refs {
+		index, ok := p.names[r.name]
+		if !ok {
+			p.fail("Invalid named capture referenced")
+		}
+		r.index = index
+	}
+	if len(p.names) == 0 {
+		p.names = nil
+	}
+	return n, p.ncap, p.names, nil
+}
+
+// prescan count capturing groups, so \N can be parsed before N-th group
+func (p *parser) prescan() {
+	inClass := 0
+	for i := 0; i < len(p.src); i++ {
+		switch p.src[i] {
+		case '\\':
+			i++
+		case '[':
+			if p.sets || inClass == 0 {
+				inClass++
+			}
+		case ']':
+			if inClass > 0 {
+				inClass--
+			}
+		case '(':
+			if inClass > 0 {
+				continue
+			}
+			if i+1 < len(p.src) && p.src[i+1] == '?' {
+				if i+3 < len(p.src) && p.src[i+2] == '<' && p.src[i+3] != '=' && p.src[i+3] != '!' {
+					p.total++
+					p.hasNames = true
+				}
+				continue
+			}
+			p.total++
+		}
+	}
+}
+
+func (p *parser) end() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	if p.end() {
+		return -1
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) peekAt(offset int) rune {
+	if p.pos+offset >= len(p.src) {
+		return -1
+	}
+	return p.src[p.pos+offset]
+}
+
+func (p *parser) next() rune {
+	c := p.peek()
+	p.pos++
+	return c
+}
+
+func (p *parser) eat(c rune) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) disjunction() node {
+	alts := []node{p.alternative()}
+	for p.eat('|') {
+		alts = append(alts, p.alternative())
+	}
+	if len(alts) == 1 {
+		return alts[0]
+	}
+	return &altNode{alts: alts}
+}
+
+func (p *parser) alternative() node {
+	seq := &seqNode{}
+	for !p.end() && p.peek() != '|' && p.peek() != ')' {
+		seq.items = append(seq.items, p.term())
+	}
+	if len(seq.items) == 1 {
+		return seq.items[0]
+	}
+	return seq
+}
+
+func (p *parser) term() node {
+	capFirst := p.ncap
+	atom, quantifiable := p.atom()
+	min, max, greedy, ok := p.quantifier()
+	if !ok {
+		return atom
+	}
+	if !quantifiable {
+		p.fail("Nothing to repeat")
+	}
+	return &repeatNode{n: atom, min: min, max: max, greedy: greedy, capFirst: capFirst, capLast: p.ncap}
+}
+
+// quantifier parse *, +, ?, {n}, {n,} or {n,m} with optional lazy ?
+// 	out of unicode mode invalid {...} is not quantifier, but literal chars
+func (p *parser) quantifier() (min, max int, greedy, ok bool) {
+	switch p.peek() {
+	case '*':
+		p.pos++
+		min, max = 0, -1
+	case '+':
+		p.pos++
+		min, max = 1, -1
+	case '?':
+		p.pos++
+		min, max = 0, 1
+	case '{':
+		start := p.pos
+		p.pos++
+		var digits bool
+		if min, digits = p.number(); !digits {
+			p.pos = start
+			if p.unicode {
+				p.fail("Incomplete quantifier")
+			}
+			return 0, 0, false, false
+		}
+		max = min
+		if p.eat(',') {
+			max = -1
+			if isDigit(p.peek()) {
+				max, _ = p.number()
+			}
+		}
+		if !p.eat('}') {
+			p.pos = start
+			if p.unicode {
+				p.fail("Incomplete quantifier")
+			}
+			return 0, 0, false, false
+		}
+		if max >= 0 && max < min {
+			p.fail("numbers out of order in {} quantifier")
+		}
+	default:
+		return 0, 0, false, false
+	}
+	return min, max, !p.eat('?'), true
+}
+
+// number parse decimal digits; too big number is clamped
+func (p *parser) number() (int, bool) {
+	start := p.pos
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	if p.pos == start {
+		return 0, false
+	}
+	n, err := strconv.Atoi(string(p.src[start:p.pos]))
+	if err != nil {
+		n = int(^uint(0) >> 2)
+	}
+	return n, true
+}
+
+func (p *parser) isQuantifierAt(pos int) bool {
+	save := p.pos
+	defer func() { p.pos = save }()
+	p.pos = pos
+	_, _, _, ok := p.quantifier()
+	return ok
+}
+
+func (p *parser) atom() (node, bool) {
+	c := p.next()
+	switch c {
+	case '^':
+		return &anchorNode{kind: anchorBegin}, false
+	case '$':
+		return &anchorNode{kind: anchorEnd}, false
+	case '.':
+		return &dotNode{}, true
+	case '(':
+		return p.group()
+	case '[':
+		return p.class(), true
+	case '*', '+', '?':
+		p.fail("Nothing to repeat")
+	case '{':
+		if p.unicode {
+			p.fail("Lone quantifier brackets")
+		}
+		if p.isQuantifierAt(p.pos - 1) {
+			p.fail("Nothing to repeat")
+		}
+	case '}', ']':
+		if p.unicode {
+			p.fail("Lone quantifier brackets")
+		}
+	case '\\':
+		return p.atomEscape()
+	}
+	return &charNode{set: runeSet(c)}, true
+}
+
+func (p *parser) group() (node, bool) {
+	if !p.eat('?') {
+		p.ncap++
+		index := p.ncap
+		n := p.disjunction()
+		p.closeGroup()
+		return &groupNode{index: index, n: n}, true
+	}
+
+	switch {
+	case p.eat(':'):
+		n := p.disjunction()
+		p.closeGroup()
+		return n, true
+	case p.eat('='), p.eat('!'):
+		negate := p.src[p.pos-1] == '!'
+		n := p.disjunction()
+		p.closeGroup()
+		return &lookNode{negate: negate, n: n}, !p.unicode
+	case p.eat('<'):
+		if p.eat('=') || p.eat('!') {
+			negate := p.src[p.pos-1] == '!'
+			n := p.disjunction()
+			p.closeGroup()
+			return &lookNode{behind: true, negate: negate, n: n}, false
+		}
+		name := p.groupName()
+		if _, ok := p.names[name]; ok {
+			p.fail("Duplicate capture group name")
+		}
+		p.ncap++
+		index := p.ncap
+		p.names[name] = index
+		n := p.disjunction()
+		p.closeGroup()
+		return &groupNode{index: index, n: n}, true
+	}
+	p.fail("Invalid group")
+	return nil, false
+}
+
+func (p *parser) closeGroup() {
+	if !p.eat(')') {
+		p.fail("Unterminated group")
+	}
+}
+
+// groupName parse name of group after "<" up to ">"
+func (p *parser) groupName() string {
+	start := p.pos
+	for !p.end() && p.peek() != '>' {
+		c := p.next()
+		first := p.pos-1 == start
+		if !(c == '$' || c == '_' || unicode.IsLetter(c) || unicode.Is(unicode.Nl, c) ||
+			!first && (unicode.IsDigit(c) || unicode.In(c, unicode.Mn, unicode.Mc, unicode.Pc) || c == 0x200c || c == 0x200d)) {
+			p.fail("Invalid capture group name")
+		}
+	}
+	if p.end() || p.pos == start {
+		p.fail("Invalid capture group name")
+	}
+	name := string(p.src[start:p.pos])
+	p.pos++
+	return name
+}
+
+func (p *parser) atomEscape() (node, bool) {
+	if p.end() {
+		p.fail("\\ at end of pattern")
+	}
+	c := p.peek()
+	switch {
+	case c == 'b':
+		p.pos++
+		return &anchorNode{kind: anchorWordBoundary}, false
+	case c == 'B':
+		p.pos++
+		return &anchorNode{kind: anchorNotWordBoundary}, false
+	case c >= '1' && c <= '9':
+		start := p.pos
+		n, _ := p.number()
+		if n <= p.total {
+			return &backrefNode{index: n}, true
+		}
+		if p.unicode {
+			p.fail("Invalid escape")
+		}
+		p.pos = start
+		if c >= '8' {
+			p.pos++
+			return &charNode{set: runeSet(c)}, true
+		}
+		return &charNode{set: runeSet(p.octal())}, true
+	case c == 'k' && (p.unicode || p.hasNames):
+		p.pos++
+		if !p.eat('<') {
+			p.fail("Invalid named reference")
+		}
+		ref := &backrefNode{name: p.groupName()}
+		p.refs = append(p.refs, ref)
+		return ref, true
+	}
+	set, _ := p.classAtomEscape(false)
+	return &charNode{set: set}, true
+}
+
+// classAtomEscape parse escape after "\" which is class or one char
+// 	char is returned as rune for ranges in classes, class as -1
+func (p *parser) classAtomEscape(inClass bool) (*charSet, rune) {
+	c := p.next()
+	switch c {
+	case 'd', 'D', 'w', 'W', 's', 'S':
+		return classEscape(c, p.unicode && p.ignoreCase), -1
+	case 'p', 'P':
+		if !p.unicode {
+			break
+		}
+		if !p.eat('{') {
+			p.fail("Invalid property name")
+		}
+		start := p.pos
+		for !p.end() && p.peek() != '}' {
+			p.pos++
+		}
+		if p.end() {
+			p.fail("Invalid property name")
+		}
+		set := property(string(p.src[start:p.pos]))
+		p.pos++
+		if set == nil {
+			p.fail("Invalid property name")
+		}
+		if c == 'P' {
+			set = complement(set)
+		}
+		return set, -1
+	}
+	p.pos--
+	r := p.charEscape(inClass)
+	return runeSet(r), r
+}
+
+// charEscape parse escape after "\" of one char
+func (p *parser) charEscape(inClass bool) rune {
+	c := p.next()
+	switch c {
+	case 't':
+		return '\t'
+	case 'n':
+		return '\n'
+	case 'v':
+		return '\v'
+	case 'f':
+		return '\f'
+	case 'r':
+		return '\r'
+	case 'b':
+		if inClass {
+			return '\b'
+		}
+	case '-':
+		if inClass {
+			return '-'
+		}
+	case 'c':
+		if l := p.peek(); l >= 'a' && l <= 'z' || l >= 'A' && l <= 'Z' {
+			p.pos++
+			return l % 32
+		}
+		if inClass && !p.unicode {
+			if l := p.peek(); isDigit(l) || l == '_' {
+				p.pos++
+				return l % 32
+			}
+		}
+		if p.unicode {
+			p.fail("Invalid unicode escape")
+		}
+		p.pos--
+		return '\\'
+	case '0':
+		if !isDigit(p.peek()) {
+			return 0
+		}
+		if p.unicode {
+			p.fail("Invalid decimal escape")
+		}
+		p.pos--
+		return p.octal()
+	case 'x':
+		if r, ok := p.hex(2); ok {
+			return r
+		}
+		if p.unicode {
+			p.fail("Invalid escape")
+		}
+		return 'x'
+	case 'u':
+		if r, ok := p.unicodeEscape(); ok {
+			return r
+		}
+		if p.unicode {
+			p.fail("Invalid Unicode escape")
+		}
+		return 'u'
+	case -1:
+		p.fail("\\ at end of pattern")
+	}
+	if !p.unicode {
+		if inClass && c >= '1' && c <= '7' {
+			p.pos--
+			return p.octal()
+		}
+		return c
+	}
+	switch c {
+	case '^', '$', '\\', '.', '*', '+', '?', '(', ')', '[', ']', '{', '}', '|', '/':
+		return c
+	}
+	if p.sets && inClass {
+		switch c {
+		case '&', '!', '#', '%', ',', ':', ';', '<', '=', '>', '@', '`', '~':
+			return c
+		}
+	}
+	p.fail("Invalid escape")
+	return 0
+}
+
+// octal parse legacy octal escape up to \377
+func (p *parser) octal() rune {
+	r := p.next() - '0'
+	if c := p.peek(); c >= '0' && c <= '7' {
+		r = r*8 + p.next() - '0'
+		if c := p.peek(); r < 32 && c >= '0' && c <= '7' {
+			r = r*8 + p.next() - '0'
+		}
+	}
+	return r
+}
+
+// hex parse n hex digits; on failure position is not changed
+func (p *parser) hex(n int) (rune, bool) {
+	if p.pos+n > len(p.src) {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(string(p.src[p.pos:p.pos+n]), 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	p.pos += n
+	return rune(v), true
+}
+
+// unicodeEscape parse \uXXXX, surrogate pair \uXXXX\uXXXX & \u{X...} after "\u"
+func (p *parser) unicodeEscape() (rune, bool) {
+	if p.unicode && p.eat('{') {
+		start := p.pos
+		for !p.end() && p.peek() != '}' {
+			p.pos++
+		}
+		v, err := strconv.ParseUint(string(p.src[start:p.pos]), 16, 32)
+		if p.end() || err != nil || v > unicode.MaxRune {
+			p.fail("Invalid Unicode escape")
+		}
+		p.pos++
+		return rune(v), true
+	}
+	r, ok := p.hex(4)
+	if !ok {
+		return 0, false
+	}
+	if p.unicode && r >= 0xd800 && r <= 0xdbff && p.peek() == '\\' && p.peekAt(1) == 'u' {
+		save := p.pos
+		p.pos += 2
+		if lo, ok := p.hex(4); ok && lo >= 0xdc00 && lo <= 0xdfff {
+			return (r-0xd800)<<10 + (lo - 0xdc00) + 0x10000, true
+		}
+		p.pos = save
+	}
+	return r, true
+}
+
+// class parse [...] after "["
+func (p *parser) class() node {
+	if p.sets {
+		return &charNode{set: p.classSet()}
+	}
+	invert := p.eat('^')
+	set := emptySet
+	for {
+		if p.end() {
+			p.fail("Unterminated character class")
+		}
+		if p.eat(']') {
+			return &charNode{set: set, invert: invert}
+		}
+		a, ac := p.classAtom()
+		if p.peek() != '-' || p.peekAt(1) == ']' || p.peekAt(1) == -1 {
+			set = union(set, a)
+			continue
+		}
+		p.pos++
+		b, bc := p.classAtom()
+		if ac < 0 || bc < 0 {
+			if p.unicode {
+				p.fail("Invalid character class")
+			}
+			set = union(union(set, a), union(runeSet('-'), b))
+			continue
+		}
+		if ac > bc {
+			p.fail("Range out of order in character class")
+		}
+		set = union(set, rangeSet(ac, bc))
+	}
+}
+
+// classAtom parse one char or class escape of class
+func (p *parser) classAtom() (*charSet, rune) {
+	c := p.next()
+	if c == '\\' {
+		return p.classAtomEscape(true)
+	}
+	return runeSet(c), c
+}
+
+// classSet parse v mode class after "[" with nested classes, -- & &&
+func (p *parser) classSet() *charSet {
+	invert := p.eat('^')
+	var set *charSet
+	if p.eat(']') {
+		set = emptySet
+	} else {
+		set = p.classSetExpression()
+	}
+	if invert {
+		if len(set.strs) > 0 {
+			p.fail("Negated character class may contain strings")
+		}
+		set = complement(set)
+	}
+	return set
+}
+
+func (p *parser) classSetExpression() *charSet {
+	set, c := p.classSetOperand()
+	op := func(s string) bool {
+		return p.peek() == rune(s[0]) && p.peekAt(1) == rune(s[1])
+	}
+	switch {
+	case op("&&"), op("--"):
+		intersection := op("&&")
+		for !p.eat(']') {
+			switch {
+			case intersection && op("&&"), !intersection && op("--"):
+				p.pos += 2
+			default:
+				p.fail("Invalid set operation in character class")
+			}
+			b, _ := p.classSetOperand()
+			if intersection {
+				set = intersect(set, b)
+			} else {
+				set = subtract(set, b)
+			}
+		}
+		return set
+	}
+
+	for {
+		if c >= 0 && p.peek() == '-' && p.peekAt(1) != '-' {
+			p.pos++
+			_, bc := p.classSetOperand()
+			if bc < 0 {
+				p.fail("Invalid character class")
+			}
+			if c > bc {
+				p.fail("Range out of order in character class")
+			}
+			set = union(set, rangeSet(c, bc))
+			c = -1
+		}
+		if p.eat(']') {
+			return set
+		}
+		if op("&&") || op("--") {
+			p.fail("Invalid set operation in character class")
+		}
+		var b *charSet
+		b, c = p.classSetOperand()
+		set = union(set, b)
+	}
+}
+
+// classSetOperand parse nested class, \q{...}, class escape or char of v mode class
+// 	char is returned also as rune for ranges, otherwise rune is -1
+func (p *parser) classSetOperand() (*charSet, rune) {
+	if p.end() {
+		p.fail("Unterminated character class")
+	}
+	c := p.next()
+	switch c {
+	case '[':
+		return p.classSet(), -1
+	case '\\':
+		if p.eat('q') {
+			return p.classStrings(), -1
+		}
+		return p.classAtomEscape(true)
+	case '(', ')', '{', '}', '/', '-', '|', ']':
+		p.fail("Invalid character in character class")
+	}
+	return runeSet(c), c
+}
+
+// classStrings parse \q{a|bc|...} after "\q"
+func (p *parser) classStrings() *charSet {
+	if !p.eat('{') {
+		p.fail("Invalid escape")
+	}
+	set := emptySet
+	var s []rune
+	for {
+		if p.end() {
+			p.fail("Unterminated character class")
+		}
+		c := p.next()
+		if c == '|' || c == '}' {
+			if len(s) == 1 {
+				set = union(set, runeSet(s[0]))
+			} else {
+				set = union(set, &charSet{has: emptySet.has, strs: [][]rune{s}})
+			}
+			s = nil
+			if c == '}' {
+				return set
+			}
+			continue
+		}
+		if c == '\\' {
+			c = p.charEscape(true)
+		}
+		s = append(s, c)
+	}
+}
@@ -0,0 +1,34 @@
+package regexp
+
+import "testing"
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		pattern, flags string
+		wantErr        bool
+		description    string
+	}{
+		{pattern: `a**`, wantErr: true, description: "nothing to repeat"},
+		{pattern: `a)`, wantErr: true, description: "unmatched paren"},
+		{pattern: `[b-a]`, wantErr: true, description: "range out of order"},
+		{pattern: `a{2,1}`, wantErr: true, description: "quantifier out of order"},
+		{pattern: `(?<a>x)(?<a>y)`, wantErr: true, description: "duplicate group name"},
+		{pattern: `\k<b>(?<a>x)`, wantErr: true, description: "unknown group name"},
+		{pattern: `(?<=a)*`, wantErr: true, description: "quantified lookbehind"},
+		{pattern: `(?=a)*`, wantErr: false, description: "quantified lookahead out of unicode mode"},
+		{pattern: `(?=a)*`, flags: "u", wantErr: true, description: "quantified lookahead in unicode mode"},
+		{pattern: `\q`, flags: "u", wantErr: true, description: "invalid escape in unicode mode"},
+		{pattern: `\q`, wantErr: false, description: "identity escape out of unicode mode"},
+		{pattern: `{`, flags: "u", wantErr: true, description: "lone brace in unicode mode"},
+		{pattern: `\p{Foo}`, flags: "u", wantErr: true, description: "unknown property"},
+		{pattern: `[^\q{ab}]`, flags: "v", wantErr: true, description: "negated class with strings"},
+		{pattern: `[a&&b--c]`, flags: "v", wantErr: true, description: "mixed set operations"},
+		{pattern: `[(]`, flags: "v", wantErr: true, description: "unescaped syntax char in v class"},
+		{pattern: `\2(a)(b)`, wantErr: false, description: "forward backreference"},
+	}
+
+	for _, tt := range tests {
+		_, err := New(tt.pattern, tt.flags)
+		TestLog("parse", t, tt.pattern, err != nil, tt.wantErr, tt.description)
+	}
+}
@@ -0,0 +1,144 @@
+package set
+
+import (
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+// SetLike is set-like value accepted by set composition methods, like js set-like record
+// 	Keys must return values of set
+type SetLike interface {
+	Size() int
+	Has(value interface{}) bool
+	Keys() array.Iterator
+}
+
+// mapKeys is set-like of Map keys
+type mapKeys struct {
+	*jsmap.Map
+}
+
+func (m mapKeys) Keys() array.Iterator {
+	return m.Map.Keys()
+}
+
+// OfMap return set-like of m keys, like js Map used as set-like
+func OfMap(m *jsmap.Map) SetLike {
+	return mapKeys{m}
+}
+
+// copy return new Set with values of s
+func (s *Set) copy() *Set {
+	return FromIterator(s.Values())
+}
+
+// Union return new Set with values of s & other, like js union
+func (s *Set) Union(other SetLike) *Set {
+	r := s.copy()
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		r.Add(v)
+	}
+	return r
+}
+
+// Intersection return new Set with values of s which are in other, like js intersection
+// 	values are in order of smaller set
+func (s *Set) Intersection(other SetLike) *Set {
+	r := New()
+	if s.Size() <= other.Size() {
+		s.ForEach(func(v, _ interface{}, _ *Set) {
+			if other.Has(v) {
+				r.Add(v)
+			}
+		})
+		return r
+	}
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		if s.Has(v) {
+			r.Add(v)
+		}
+	}
+	return r
+}
+
+// Difference return new Set with values of s which are not in other, like js difference
+func (s *Set) Difference(other SetLike) *Set {
+	r := s.copy()
+	if s.Size() <= other.Size() {
+		s.ForEach(func(v, _ interface{}, _ *Set) {
+			if other.Has(v) {
+				r.Delete(v)
+			}
+		})
+		return r
+	}
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		r.Delete(v)
+	}
+	return r
+}
+
+// SymmetricDifference return new Set with values which are only in s or only in other, like js symmetricDifference
+func (s *Set) SymmetricDifference(other SetLike) *Set {
+	r := s.copy()
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		if s.Has(v) {
+			r.Delete(v)
+		} else {
+			r.Add(v)
+		}
+	}
+	return r
+}
+
+// IsSubsetOf check are all values of s in other, like js isSubsetOf
+func (s *Set) IsSubsetOf(other SetLike) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	it := s.Values()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf check are all values of other in s, like js isSupersetOf
+func (s *Set) IsSupersetOf(other SetLike) bool {
+	if s.Size() < other.Size() {
+		return false
+	}
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		if !s.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDisjointFrom check are there no common values of s & other, like js isDisjointFrom
+func (s *Set) IsDisjointFrom(other SetLike) bool {
+	if s.Size() <= other.Size() {
+		it := s.Values()
+		for v, done := it.Next(); !done; v, done = it.Next() {
+			if other.Has(v) {
+				return false
+			}
+		}
+		return true
+	}
+	it := other.Keys()
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		if s.Has(v) {
+			return false
+		}
+	}
+	return true
+}
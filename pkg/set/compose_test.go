@@ -0,0 +1,58 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+func TestSetCompose(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(5, 4, 3)
+	tests := []struct {
+		name        string
+		got         *Set
+		want        *array.Array
+		description string
+	}{
+		{name: "Union", got: a.Union(b), want: array.MakeArray(1, 2, 3, 4, 5), description: "union"},
+		{name: "Intersection", got: a.Intersection(b), want: array.MakeArray(4, 3), description: "order of smaller set"},
+		{name: "Intersection", got: b.Intersection(a), want: array.MakeArray(4, 3), description: "order of this"},
+		{name: "Difference", got: a.Difference(b), want: array.MakeArray(1, 2), description: "difference"},
+		{name: "Difference", got: b.Difference(a), want: array.MakeArray(5), description: "smaller difference"},
+		{name: "SymmetricDifference", got: a.SymmetricDifference(b), want: array.MakeArray(1, 2, 5), description: "symmetric difference"},
+	}
+
+	for _, tt := range tests {
+		TestLog(tt.name, t, "a,b", tt.got.ToArray(), tt.want, tt.description)
+	}
+	TestLog("Union", t, "a", a.ToArray(), array.MakeArray(1, 2, 3, 4), "this is not changed")
+}
+
+func TestSetPredicates(t *testing.T) {
+	tests := []struct {
+		name        string
+		got, want   bool
+		description string
+	}{
+		{name: "IsSubsetOf", got: New(1, 2).IsSubsetOf(New(2, 1, 3)), want: true, description: "subset"},
+		{name: "IsSubsetOf", got: New(1, 4).IsSubsetOf(New(1, 2, 3)), want: false, description: "not subset"},
+		{name: "IsSupersetOf", got: New(1, 2, 3).IsSupersetOf(New(3, 1)), want: true, description: "superset"},
+		{name: "IsSupersetOf", got: New(1).IsSupersetOf(New(1, 2)), want: false, description: "smaller is not superset"},
+		{name: "IsDisjointFrom", got: New(1, 2).IsDisjointFrom(New(3, 4, 5)), want: true, description: "disjoint"},
+		{name: "IsDisjointFrom", got: New(1, 2, 3).IsDisjointFrom(New(3)), want: false, description: "common value"},
+		{name: "IsSubsetOf", got: New().IsSubsetOf(New()), want: true, description: "empty sets"},
+	}
+
+	for _, tt := range tests {
+		TestLog(tt.name, t, tt.description, tt.got, tt.want, tt.description)
+	}
+}
+
+func TestSetOfMap(t *testing.T) {
+	m := jsmap.New(jsmap.Entry{Key: 1, Value: "x"}, jsmap.Entry{Key: 5, Value: "y"})
+	got := New(1, 2).Union(OfMap(m))
+	TestLog("Union", t, "map", got.ToArray(), array.MakeArray(1, 2, 5), "map keys are set-like")
+	TestLog("IsSubsetOf", t, "map", New(5).IsSubsetOf(OfMap(m)), true, "subset of map keys")
+}
@@ -0,0 +1,131 @@
+package set
+
+import (
+	"encoding/json"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+// Set is js Set: values are compared by SameValueZero & iterated in insertion order
+// 	zero Set is empty set ready to use; Set is not safe for concurrent use
+type Set struct {
+	m jsmap.Map
+}
+
+// New return new Set of values, like js new Set(values)
+func New(values ...interface{}) *Set {
+	s := &Set{}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// FromArray return new Set of Array elements, like js new Set(arr)
+func FromArray(arr *array.Array) *Set {
+	s := &Set{}
+	for _, v := range arr.Items {
+		s.Add(v.Data)
+	}
+	return s
+}
+
+// FromIterator return new Set of iterator values
+func FromIterator(it array.Iterator) *Set {
+	s := &Set{}
+	for v, done := it.Next(); !done; v, done = it.Next() {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add add value to end if there is no such value & return s
+func (s *Set) Add(value interface{}) *Set {
+	if !s.m.Has(value) {
+		s.m.Set(value, nil)
+	}
+	return s
+}
+
+// Has check is there value in s
+func (s *Set) Has(value interface{}) bool {
+	return s.m.Has(value)
+}
+
+// Delete remove value; return false if there was no value
+func (s *Set) Delete(value interface{}) bool {
+	return s.m.Delete(value)
+}
+
+// Clear remove all values
+func (s *Set) Clear() {
+	s.m.Clear()
+}
+
+// Size return count of values
+func (s *Set) Size() int {
+	return s.m.Size()
+}
+
+// ForEach call callback for each value in insertion order, like js forEach
+// 	value & key are same; values added during iteration are visited, deleted are skipped
+func (s *Set) ForEach(callback func(value, key interface{}, s *Set)) {
+	s.m.ForEach(func(_, key interface{}, _ *jsmap.Map) {
+		callback(key, key, s)
+	})
+}
+
+// Values return live iterator of values, like js values
+func (s *Set) Values() array.Iterator {
+	return s.m.Keys()
+}
+
+// Keys is same as Values, like js keys
+func (s *Set) Keys() array.Iterator {
+	return s.m.Keys()
+}
+
+// Entries return live iterator of jsmap.Entry with value as key & value, like js entries
+func (s *Set) Entries() array.Iterator {
+	return &entries{s.m.Keys()}
+}
+
+type entries struct {
+	keys array.Iterator
+}
+
+func (e *entries) Next() (interface{}, bool) {
+	v, done := e.keys.Next()
+	if done {
+		return nil, true
+	}
+	return jsmap.Entry{Key: v, Value: v}, false
+}
+
+// ToArray return Array of values, like js Array.from(set)
+func (s *Set) ToArray() *array.Array {
+	return s.m.KeysArray()
+}
+
+// MarshalJSON encode s as array of values in insertion order
+func (s *Set) MarshalJSON() ([]byte, error) {
+	values := make([]interface{}, 0, s.Size())
+	s.ForEach(func(value, _ interface{}, _ *Set) {
+		values = append(values, value)
+	})
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON decode array of values; all previous values are removed
+func (s *Set) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+	return nil
+}
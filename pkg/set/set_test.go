@@ -0,0 +1,90 @@
+package set
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestSetFromArray(t *testing.T) {
+	tests := []struct {
+		incoming    *array.Array
+		want        *array.Array
+		description string
+	}{
+		{
+			incoming:    array.MakeArray(1, 2, 1, 3, 2),
+			want:        array.MakeArray(1, 2, 3),
+			description: "dedupe keeps first occurrence order",
+		},
+		{
+			incoming:    array.MakeArray(math.NaN(), math.NaN(), 1, 1.0),
+			want:        array.MakeArray(math.NaN(), 1, 1.0),
+			description: "NaN is deduped, different go types are not",
+		},
+		{
+			incoming:    array.MakeArray(),
+			want:        array.MakeArray(),
+			description: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		got := FromArray(tt.incoming).ToArray()
+		if len(got.Items) > 0 && got.Items[0].Data != got.Items[0].Data {
+			// NaN is not DeepEqual to itself
+			TestLog("FromArray", t, tt.incoming, len(got.Items), len(tt.want.Items), tt.description)
+			continue
+		}
+		TestLog("FromArray", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
+
+func TestSetMethods(t *testing.T) {
+	var s Set
+	s.Add("a").Add("b").Add("a")
+	TestLog("Size", t, "a,b", s.Size(), 2, "duplicate add")
+	TestLog("Has", t, "a", s.Has("a"), true, "has")
+	TestLog("Delete", t, "a", s.Delete("a"), true, "delete")
+	TestLog("Delete", t, "a", s.Delete("a"), false, "delete missing")
+
+	s.Add("c")
+	var got []interface{}
+	s.ForEach(func(value, key interface{}, s *Set) {
+		got = append(got, value, key)
+		if value == "b" {
+			s.Add("d")
+		}
+	})
+	TestLog("ForEach", t, "b,c", got, []interface{}{"b", "b", "c", "c", "d", "d"}, "value is key, added visited")
+
+	e, _ := s.Entries().Next()
+	TestLog("Entries", t, "b", e, jsmap.Entry{Key: "b", Value: "b"}, "entry of value")
+
+	s.Clear()
+	TestLog("Clear", t, "b,c,d", s.Size(), 0, "clear")
+}
+
+func TestSetJSON(t *testing.T) {
+	s := New("b", 1, nil)
+	data, _ := json.Marshal(s)
+	TestLog("MarshalJSON", t, s, string(data), `["b",1,null]`, "array of values")
+
+	got := New()
+	err := json.Unmarshal([]byte(`[1,2,1]`), got)
+	TestLog("UnmarshalJSON", t, "[1,2,1]", got.ToArray(), array.MakeArray(1.0, 2.0), "deduped values")
+	TestLog("UnmarshalJSON", t, "[1,2,1]", err, nil, "no error")
+}
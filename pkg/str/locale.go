@@ -0,0 +1,70 @@
+package str
+
+import "unicode"
+
+// base letters of accented latin letters
+var accents = map[rune]rune{}
+
+func init() {
+	for base, list := range map[rune]string{
+		'a': "àáâãäåāăą", 'c': "çćĉċč", 'd': "ďđ", 'e': "èéêëēĕėęě", 'g': "ĝğġģ", 'h': "ĥħ",
+		'i': "ìíîïĩīĭįı", 'j': "ĵ", 'k': "ķ", 'l': "ĺļľŀł", 'n': "ñńņňŉ", 'o': "òóôõöøōŏő",
+		'r': "ŕŗř", 's': "śŝşšș", 't': "ţťŧț", 'u': "ùúûüũūŭůűų", 'w': "ŵ", 'y': "ýÿŷ", 'z': "źżž",
+	} {
+		for _, r := range list {
+			accents[r] = base
+			accents[unicode.ToUpper(r)] = unicode.ToUpper(base)
+		}
+	}
+}
+
+func baseLetter(r rune) rune {
+	if b, ok := accents[r]; ok {
+		return b
+	}
+	return r
+}
+
+// LocaleCompare compare a & b like js localeCompare with default collation
+// 	letters are compared first without accents & case, then accents, then case (lower before upper)
+// 	it's an approximation of root locale collation without full unicode tables
+// 	return -1 if a is before b, 1 if after, 0 if equal
+func LocaleCompare(a, b string) int {
+	levels := []func(r rune) rune{
+		func(r rune) rune { return unicode.ToLower(baseLetter(r)) },
+		unicode.ToLower,
+		func(r rune) rune {
+			// lower case is before upper case
+			if unicode.IsUpper(r) {
+				return 1
+			}
+			return 0
+		},
+	}
+	ra, rb := []rune(a), []rune(b)
+	for _, key := range levels {
+		if c := compareRunes(ra, rb, key); c != 0 {
+			return c
+		}
+	}
+	return compareRunes(ra, rb, func(r rune) rune { return r })
+}
+
+func compareRunes(a, b []rune, key func(r rune) rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ka, kb := key(a[i]), key(b[i])
+		if ka < kb {
+			return -1
+		}
+		if ka > kb {
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
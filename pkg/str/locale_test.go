@@ -0,0 +1,25 @@
+package str
+
+import "testing"
+
+func TestLocaleCompare(t *testing.T) {
+	tests := []struct {
+		a, b        string
+		want        int
+		description string
+	}{
+		{"a", "b", -1, "letters"},
+		{"a", "B", -1, "case insensitive first"},
+		{"a", "A", -1, "lower before upper"},
+		{"résumé", "resume", 1, "accent after plain"},
+		{"résumé", "resumes", -1, "accent less than letter"},
+		{"é", "f", -1, "accented letter order"},
+		{"abc", "abc", 0, "equal"},
+		{"ab", "abc", -1, "prefix"},
+	}
+
+	for _, tt := range tests {
+		got := LocaleCompare(tt.a, tt.b)
+		TestLog("LocaleCompare", t, tt.a+" "+tt.b, got, tt.want, tt.description)
+	}
+}
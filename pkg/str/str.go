@@ -0,0 +1,251 @@
+package str
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// all indexes & lengths are in UTF-16 code units, like in js
+// lone surrogates are kept in strings as WTF-8, see jsstring package
+
+// Length return length of s in UTF-16 code units, like js length
+func Length(s string) int {
+	return len(units(s))
+}
+
+// Split return array of s parts divided by separator, like js split
+// 	if separator is empty, s is split to code units
+// 	if limit >= 0, array has at most limit elements
+func Split(s, separator string, limit int) *array.Array {
+	r := array.NewArray()
+	if limit == 0 {
+		return r
+	}
+	u, sep := units(s), units(separator)
+	add := func(part []uint16) bool {
+		r.Push(fromUnits(part))
+		return limit > 0 && len(r.Items) >= limit
+	}
+
+	if len(sep) == 0 {
+		for i := range u {
+			if add(u[i : i+1]) {
+				break
+			}
+		}
+		return r
+	}
+
+	start := 0
+	for {
+		i := u.Index(sep, start)
+		if i < 0 {
+			break
+		}
+		if add(u[start:i]) {
+			return r
+		}
+		start = i + len(sep)
+	}
+	add(u[start:])
+	return r
+}
+
+func pad(s string, targetLength int, padString string, atStart bool) string {
+	u, p := units(s), units(padString)
+	if targetLength <= len(u) || len(p) == 0 {
+		return s
+	}
+	fill := make([]uint16, 0, targetLength-len(u))
+	for len(fill) < targetLength-len(u) {
+		fill = append(fill, p...)
+	}
+	fill = fill[:targetLength-len(u)]
+	if atStart {
+		return fromUnits(append(fill, u...))
+	}
+	return fromUnits(append(u, fill...))
+}
+
+// PadStart return s padded from start by padString to targetLength, like js padStart
+// 	pad of several code units can be cut at the end
+func PadStart(s string, targetLength int, padString string) string {
+	return pad(s, targetLength, padString, true)
+}
+
+// PadEnd return s padded from end by padString to targetLength, like js padEnd
+func PadEnd(s string, targetLength int, padString string) string {
+	return pad(s, targetLength, padString, false)
+}
+
+// At return code unit at index as string, like js at
+// 	if index < 0, then count from end; ok is false for index out of range
+func At(s string, index int) (string, bool) {
+	u := units(s)
+	if index < 0 {
+		index += len(u)
+	}
+	if index < 0 || index >= len(u) {
+		return "", false
+	}
+	return fromUnits(u[index : index+1]), true
+}
+
+// Slice return part of s between start & end, like js slice
+// 	if start/end < 0, then count from end
+func Slice(s string, start, end int) string {
+	u := units(s)
+	from, to := relative(start, len(u)), relative(end, len(u))
+	if from >= to {
+		return ""
+	}
+	return fromUnits(u[from:to])
+}
+
+// Substring return part of s between start & end, like js substring
+// 	negative start/end are 0, start & end are swapped if start > end
+func Substring(s string, start, end int) string {
+	u := units(s)
+	from, to := clamp(start, len(u)), clamp(end, len(u))
+	if from > to {
+		from, to = to, from
+	}
+	return fromUnits(u[from:to])
+}
+
+// Substr return length code units of s from start, like js substr
+// 	if start < 0, then count from end
+func Substr(s string, start, length int) string {
+	u := units(s)
+	from := relative(start, len(u))
+	to := clamp(from+clamp(length, len(u)), len(u))
+	if from >= to {
+		return ""
+	}
+	return fromUnits(u[from:to])
+}
+
+// TrimStart return s without leading whitespaces, like js trimStart
+func TrimStart(s string) string {
+	for i, r := range s {
+		if !conv.IsSpace(r) {
+			return s[i:]
+		}
+	}
+	return ""
+}
+
+// TrimEnd return s without trailing whitespaces, like js trimEnd
+// 	s is cut by bytes, so lone surrogates of WTF-8 are kept as is
+func TrimEnd(s string) string {
+	i := len(s)
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:i])
+		if !conv.IsSpace(r) {
+			break
+		}
+		i -= size
+	}
+	return s[:i]
+}
+
+// Trim return s without leading & trailing whitespaces, like js trim
+func Trim(s string) string {
+	return TrimEnd(TrimStart(s))
+}
+
+// Repeat return s repeated count times, like js repeat
+// 	return array.RangeError for negative count
+func Repeat(s string, count int) (string, error) {
+	if count < 0 {
+		return "", &array.RangeError{Message: "Invalid count value: " + strconv.Itoa(count)}
+	}
+	r := make([]byte, 0, len(s)*count)
+	for i := 0; i < count; i++ {
+		r = append(r, s...)
+	}
+	return string(r), nil
+}
+
+// StartsWith check is s starts with search at position, like js startsWith
+func StartsWith(s, search string, position int) bool {
+	u, sub := units(s), units(search)
+	p := clamp(position, len(u))
+	return p+len(sub) <= len(u) && jsstring.Equal(u[p:p+len(sub)], sub)
+}
+
+// EndsWith check is s ends with search before endPosition, like js endsWith
+// 	use Length(s) as endPosition to check end of s
+func EndsWith(s, search string, endPosition int) bool {
+	u, sub := units(s), units(search)
+	e := clamp(endPosition, len(u))
+	return e-len(sub) >= 0 && jsstring.Equal(u[e-len(sub):e], sub)
+}
+
+// ReplaceAll return s with all search occurrences replaced, like js replaceAll
+// 	replacement patterns: $$ is "$", $& is matched string, $` is part before match, $' is part after match
+func ReplaceAll(s, search, replacement string) string {
+	u, sub, rep := units(s), units(search), units(replacement)
+	var positions []int
+	advance := len(sub)
+	if advance == 0 {
+		advance = 1
+	}
+	for i := u.Index(sub, 0); i >= 0; i = u.Index(sub, i+advance) {
+		positions = append(positions, i)
+		if i+advance > len(u) {
+			break
+		}
+	}
+
+	r := []uint16{}
+	end := 0
+	for _, p := range positions {
+		r = append(r, u[end:p]...)
+		r = append(r, substitution(u, p, p+len(sub), rep)...)
+		end = p + len(sub)
+	}
+	return fromUnits(append(r, u[end:]...))
+}
+
+// substitution expand replacement patterns for match u[start:end], like js GetSubstitution
+func substitution(u []uint16, start, end int, rep []uint16) []uint16 {
+	r := []uint16{}
+	for i := 0; i < len(rep); i++ {
+		if rep[i] != '$' || i+1 >= len(rep) {
+			r = append(r, rep[i])
+			continue
+		}
+		switch rep[i+1] {
+		case '$':
+			r = append(r, '$')
+		case '&':
+			r = append(r, u[start:end]...)
+		case '`':
+			r = append(r, u[:start]...)
+		case '\'':
+			r = append(r, u[end:]...)
+		default:
+			r = append(r, '$')
+			continue
+		}
+		i++
+	}
+	return r
+}
+
+// CharCodeAt return UTF-16 code unit at index, like js charCodeAt
+// 	ok is false for index out of range
+func CharCodeAt(s string, index int) (uint16, bool) {
+	return units(s).CharCodeAt(index)
+}
+
+// CodePointAt return code point starting at index, like js codePointAt
+// 	lone surrogate is returned as is; ok is false for index out of range
+func CodePointAt(s string, index int) (rune, bool) {
+	return units(s).CodePointAt(index)
+}
@@ -0,0 +1,175 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		s, separator string
+		limit        int
+		want         *array.Array
+		description  string
+	}{
+		{"a,b,c", ",", -1, array.MakeArray("a", "b", "c"), "split by comma"},
+		{"a,b,c", ",", 2, array.MakeArray("a", "b"), "limit"},
+		{"a,b,c", ",", 0, array.NewArray(), "zero limit"},
+		{"a, b", ", ", -1, array.MakeArray("a", "b"), "multi char separator"},
+		{",a,", ",", -1, array.MakeArray("", "a", ""), "empty parts"},
+		{"abc", "", -1, array.MakeArray("a", "b", "c"), "empty separator"},
+		{"", ",", -1, array.MakeArray(""), "empty string"},
+		{"", "", -1, array.NewArray(), "empty string & separator"},
+		{"abc", "x", -1, array.MakeArray("abc"), "no separator in string"},
+	}
+
+	for _, tt := range tests {
+		got := Split(tt.s, tt.separator, tt.limit)
+		TestLog("Split", t, tt.s, got, tt.want, tt.description)
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		got, want   string
+		description string
+	}{
+		{PadStart("5", 3, "0"), "005", "padStart"},
+		{PadStart("abc", 10, "123"), "1231231abc", "padStart cut pad"},
+		{PadStart("abc", 2, "0"), "abc", "padStart shorter target"},
+		{PadStart("abc", 5, ""), "abc", "padStart empty pad"},
+		{PadEnd("abc", 6, "12"), "abc121", "padEnd"},
+		{PadEnd("😀", 3, "-"), "😀-", "padEnd surrogate pair length"},
+	}
+
+	for _, tt := range tests {
+		TestLog("Pad", t, tt.description, tt.got, tt.want, tt.description)
+	}
+}
+
+func TestAt(t *testing.T) {
+	tests := []struct {
+		s           string
+		index       int
+		want        string
+		ok          bool
+		description string
+	}{
+		{"abc", 0, "a", true, "first"},
+		{"abc", -1, "c", true, "last"},
+		{"abc", 3, "", false, "out of range"},
+		{"abc", -4, "", false, "negative out of range"},
+	}
+
+	for _, tt := range tests {
+		got, ok := At(tt.s, tt.index)
+		TestLog("At", t, tt.index, got, tt.want, tt.description)
+		TestLog("At", t, tt.index, ok, tt.ok, tt.description+" ok")
+	}
+}
+
+func TestSliceSubstring(t *testing.T) {
+	tests := []struct {
+		got, want   string
+		description string
+	}{
+		{Slice("hello", 1, 3), "el", "slice"},
+		{Slice("hello", -3, -1), "ll", "slice negative"},
+		{Slice("hello", 3, 1), "", "slice end before start"},
+		{Slice("hello", 2, 100), "llo", "slice end out of range"},
+		{Substring("hello", 3, 1), "el", "substring swap"},
+		{Substring("hello", -3, 2), "he", "substring negative is zero"},
+		{Substr("hello", -3, 2), "ll", "substr negative start"},
+		{Substr("hello", 1, -1), "", "substr negative length"},
+		{Substr("hello", 1, 100), "ello", "substr long length"},
+		{Slice("a😀b", 1, 3), "😀", "slice utf-16 indexes"},
+	}
+
+	for _, tt := range tests {
+		TestLog("Slice", t, tt.description, tt.got, tt.want, tt.description)
+	}
+}
+
+func TestTrimRepeat(t *testing.T) {
+	TestLog("TrimStart", t, "spaces", TrimStart(" \t\u3000a b "), "a b ", "trimStart")
+	TestLog("TrimEnd", t, "spaces", TrimEnd(" a b\n\ufeff"), " a b", "trimEnd")
+	TestLog("Trim", t, "spaces", Trim("\u0085a\u0085"), "\u0085a\u0085", "NEL is not js whitespace")
+	TestLog("Trim", t, "spaces", Trim("\u1680\u202fa\u205f"), "a", "Zs whitespace")
+	// lone surrogate U+D800 in WTF-8
+	lone := "\xed\xa0\x80"
+	TestLog("TrimEnd", t, "lone surrogate", TrimEnd(lone+" "), lone, "lone surrogate is kept")
+	TestLog("Trim", t, "lone surrogate", Trim(" "+lone+"a"+lone+"\t"), lone+"a"+lone, "lone surrogates are kept")
+
+	got, err := Repeat("ab", 3)
+	TestLog("Repeat", t, 3, got, "ababab", "repeat")
+	TestLog("Repeat", t, 3, err, nil, "repeat error")
+	_, err = Repeat("ab", -1)
+	_, ok := err.(*array.RangeError)
+	TestLog("Repeat", t, -1, ok, true, "negative count")
+}
+
+func TestStartsEndsWith(t *testing.T) {
+	tests := []struct {
+		got, want   bool
+		description string
+	}{
+		{StartsWith("hello", "he", 0), true, "startsWith"},
+		{StartsWith("hello", "ll", 2), true, "startsWith position"},
+		{StartsWith("hello", "he", 1), false, "startsWith wrong position"},
+		{StartsWith("hello", "", 10), true, "startsWith empty"},
+		{EndsWith("hello", "lo", Length("hello")), true, "endsWith"},
+		{EndsWith("hello", "ll", 4), true, "endsWith position"},
+		{EndsWith("hello", "hello!", 5), false, "endsWith longer search"},
+	}
+
+	for _, tt := range tests {
+		TestLog("StartsEndsWith", t, tt.description, tt.got, tt.want, tt.description)
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		s, search, replacement string
+		want                   string
+		description            string
+	}{
+		{"a-b-c", "-", "+", "a+b+c", "replace all"},
+		{"aaa", "aa", "b", "ba", "not overlapped"},
+		{"ab", "", "-", "-a-b-", "empty search"},
+		{"a-b", "-", "[$&]", "a[-]b", "matched pattern"},
+		{"a-b", "-", "$`$'", "aabb", "before & after patterns"},
+		{"a-b", "-", "$$1$", "a$1$b", "dollar patterns"},
+	}
+
+	for _, tt := range tests {
+		got := ReplaceAll(tt.s, tt.search, tt.replacement)
+		TestLog("ReplaceAll", t, tt.s, got, tt.want, tt.description)
+	}
+}
+
+func TestCodeUnits(t *testing.T) {
+	s := "a😀"
+	TestLog("Length", t, s, Length(s), 3, "surrogate pair is 2 code units")
+
+	c, ok := CharCodeAt(s, 1)
+	TestLog("CharCodeAt", t, 1, c, uint16(0xd83d), "high surrogate")
+	TestLog("CharCodeAt", t, 1, ok, true, "in range")
+	_, ok = CharCodeAt(s, 3)
+	TestLog("CharCodeAt", t, 3, ok, false, "out of range")
+
+	r, _ := CodePointAt(s, 1)
+	TestLog("CodePointAt", t, 1, r, '😀', "full code point")
+	r, _ = CodePointAt(s, 2)
+	TestLog("CodePointAt", t, 2, r, rune(0xde00), "low surrogate")
+}
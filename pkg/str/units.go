@@ -0,0 +1,32 @@
+package str
+
+import "github.com/miron-developer/golang-js-utils/pkg/jsstring"
+
+// units return UTF-16 code units of s; lone surrogates are kept
+func units(s string) jsstring.JSString {
+	return jsstring.New(s)
+}
+
+// fromUnits return string of UTF-16 code units; lone surrogates are WTF-8 encoded
+func fromUnits(u []uint16) string {
+	return jsstring.JSString(u).String()
+}
+
+// clamp return i clamped to [0, length]
+func clamp(i, length int) int {
+	if i < 0 {
+		return 0
+	}
+	if i > length {
+		return length
+	}
+	return i
+}
+
+// relative return i clamped to [0, length], negative i is counted from end
+func relative(i, length int) int {
+	if i < 0 {
+		i += length
+	}
+	return clamp(i, length)
+}
@@ -0,0 +1,356 @@
+package typedarray
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// Forever is Wait timeout without time limit
+const Forever time.Duration = -1
+
+// wait results, like in js
+const (
+	WaitOK       = "ok"
+	WaitNotEqual = "not-equal"
+	WaitTimedOut = "timed-out"
+)
+
+type atomics struct{}
+
+// Atomics is set of atomic operations on integer typed arrays, like js Atomics
+// 	operations are allowed for Int8, Uint8, Int16, Uint16, Int32, Uint32, BigInt64 & BigUint64 kinds
+// 	operations return old element value
+var Atomics atomics
+
+// element return address of element, its bits width and shift inside aligned word
+type element struct {
+	word  unsafe.Pointer
+	size  int
+	shift uint
+}
+
+func (atomics) element(t *TypedArray, index int) (element, error) {
+	switch t.kind {
+	case Uint8Clamped, Float32, Float64:
+		return element{}, &array.TypeError{Message: "[object Array] is not an integer shared typed array."}
+	}
+	if index < 0 || index >= t.Length() {
+		return element{}, &array.RangeError{Message: "Invalid atomic access index"}
+	}
+
+	size := t.kind.BytesPerElement()
+	off := t.byteOffset + index*size
+	if size == 8 {
+		return element{word: unsafe.Pointer(&t.buffer.data[off]), size: 8}, nil
+	}
+	// bytes & halfwords are changed inside aligned 32 bit word
+	base := off &^ 3
+	return element{word: unsafe.Pointer(&t.buffer.data[base]), size: size, shift: uint(off-base) * 8}, nil
+}
+
+func (e element) load() uint64 {
+	if e.size == 8 {
+		return atomic.LoadUint64((*uint64)(e.word))
+	}
+	return e.extract(atomic.LoadUint32((*uint32)(e.word)))
+}
+
+func (e element) mask() uint32 {
+	if e.size == 4 {
+		return math.MaxUint32
+	}
+	return uint32(1)<<(uint(e.size)*8) - 1
+}
+
+func (e element) extract(w uint32) uint64 {
+	// buffer is little endian, word is read in native order
+	var b [4]byte
+	*(*uint32)(unsafe.Pointer(&b[0])) = w
+	w = binary.LittleEndian.Uint32(b[:])
+	return uint64(w >> e.shift & e.mask())
+}
+
+func (e element) insert(w uint32, v uint64) uint32 {
+	var b [4]byte
+	*(*uint32)(unsafe.Pointer(&b[0])) = w
+	le := binary.LittleEndian.Uint32(b[:])
+	le = le&^(e.mask()<<e.shift) | (uint32(v)&e.mask())<<e.shift
+	binary.LittleEndian.PutUint32(b[:], le)
+	return *(*uint32)(unsafe.Pointer(&b[0]))
+}
+
+// update atomically replace element by fn result; return old element
+func (e element) update(fn func(old uint64) uint64) uint64 {
+	if e.size == 8 {
+		p := (*uint64)(e.word)
+		for {
+			old := atomic.LoadUint64(p)
+			if atomic.CompareAndSwapUint64(p, old, nativeUint64(fn(leUint64(old)))) {
+				return leUint64(old)
+			}
+		}
+	}
+	p := (*uint32)(e.word)
+	for {
+		w := atomic.LoadUint32(p)
+		old := e.extract(w)
+		if atomic.CompareAndSwapUint32(p, w, e.insert(w, fn(old))) {
+			return old
+		}
+	}
+}
+
+// leUint64 convert native word to little endian value and back
+func leUint64(w uint64) uint64 {
+	var b [8]byte
+	*(*uint64)(unsafe.Pointer(&b[0])) = w
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+func nativeUint64(v uint64) uint64 {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return *(*uint64)(unsafe.Pointer(&b[0]))
+}
+
+// bits return element bits of value
+func bits(kind Kind, value interface{}) uint64 {
+	if kind.IsBigInt() {
+		return toBigUint64(value)
+	}
+	return toIntN(toNumber(value), uint(kind.BytesPerElement())*8)
+}
+
+// decode return element value of bits
+func decode(kind Kind, v uint64) interface{} {
+	switch kind {
+	case Int8:
+		return float64(int8(v))
+	case Int16:
+		return float64(int16(v))
+	case Int32:
+		return float64(int32(v))
+	case BigInt64:
+		return int64(v)
+	case BigUint64:
+		return v
+	}
+	return float64(v)
+}
+
+func (a atomics) rmw(t *TypedArray, index int, value interface{}, fn func(old, v uint64) uint64) (interface{}, error) {
+	e, err := a.element(t, index)
+	if err != nil {
+		return nil, err
+	}
+	v := bits(t.kind, value)
+	old := e.update(func(old uint64) uint64 { return fn(old, v) })
+	return decode(t.kind, old), nil
+}
+
+// Add add value to element
+func (a atomics) Add(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return old + v })
+}
+
+// Sub subtract value from element
+func (a atomics) Sub(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return old - v })
+}
+
+// And set element to bitwise and with value
+func (a atomics) And(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return old & v })
+}
+
+// Or set element to bitwise or with value
+func (a atomics) Or(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return old | v })
+}
+
+// Xor set element to bitwise xor with value
+func (a atomics) Xor(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return old ^ v })
+}
+
+// Exchange set element to value
+func (a atomics) Exchange(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	return a.rmw(t, index, value, func(old, v uint64) uint64 { return v })
+}
+
+// CompareExchange set element to replacement if it's equal to expected
+func (a atomics) CompareExchange(t *TypedArray, index int, expected, replacement interface{}) (interface{}, error) {
+	e, err := a.element(t, index)
+	if err != nil {
+		return nil, err
+	}
+	exp, rep := bits(t.kind, expected), bits(t.kind, replacement)
+	old := e.update(func(old uint64) uint64 {
+		if old == exp {
+			return rep
+		}
+		return old
+	})
+	return decode(t.kind, old), nil
+}
+
+// Load return element
+func (a atomics) Load(t *TypedArray, index int) (interface{}, error) {
+	e, err := a.element(t, index)
+	if err != nil {
+		return nil, err
+	}
+	if e.size == 8 {
+		return decode(t.kind, leUint64(e.load())), nil
+	}
+	return decode(t.kind, e.load()), nil
+}
+
+// Store set element to value; return stored value
+func (a atomics) Store(t *TypedArray, index int, value interface{}) (interface{}, error) {
+	e, err := a.element(t, index)
+	if err != nil {
+		return nil, err
+	}
+	v := bits(t.kind, value)
+	e.update(func(uint64) uint64 { return v })
+	return decode(t.kind, v), nil
+}
+
+// IsLockFree check are operations on size bytes elements lock free
+func (atomics) IsLockFree(size int) bool {
+	return size == 1 || size == 2 || size == 4 || size == 8
+}
+
+type waitKey struct {
+	buffer *ArrayBuffer
+	offset int
+}
+
+type waiter struct {
+	ch chan struct{}
+}
+
+var waiters = struct {
+	sync.Mutex
+	lists map[waitKey][]*waiter
+}{lists: map[waitKey][]*waiter{}}
+
+// enqueue add waiter if element is equal to value
+func (a atomics) enqueue(t *TypedArray, index int, value interface{}) (*waiter, waitKey, string, error) {
+	if t.kind != Int32 && t.kind != BigInt64 {
+		return nil, waitKey{}, "", &array.TypeError{Message: "[object Array] is not an int32 or BigInt64 typed array."}
+	}
+	if !t.buffer.shared {
+		return nil, waitKey{}, "", &array.TypeError{Message: "Atomics.wait cannot be called on not shared buffer"}
+	}
+	if index < 0 || index >= t.Length() {
+		return nil, waitKey{}, "", &array.RangeError{Message: "Invalid atomic access index"}
+	}
+
+	key := waitKey{buffer: t.buffer, offset: t.byteOffset + index*t.kind.BytesPerElement()}
+	waiters.Lock()
+	defer waiters.Unlock()
+	cur, _ := a.Load(t, index)
+	if cur != decode(t.kind, bits(t.kind, value)) {
+		return nil, key, WaitNotEqual, nil
+	}
+	w := &waiter{ch: make(chan struct{}, 1)}
+	waiters.lists[key] = append(waiters.lists[key], w)
+	return w, key, "", nil
+}
+
+// remove waiter from queue; return false if it's already notified
+func remove(key waitKey, w *waiter) bool {
+	waiters.Lock()
+	defer waiters.Unlock()
+	list := waiters.lists[key]
+	for i, v := range list {
+		if v == w {
+			waiters.lists[key] = append(list[:i:i], list[i+1:]...)
+			if len(waiters.lists[key]) == 0 {
+				delete(waiters.lists, key)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func await(key waitKey, w *waiter, timeout time.Duration) string {
+	if timeout < 0 {
+		<-w.ch
+		return WaitOK
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-w.ch:
+		return WaitOK
+	case <-timer.C:
+		if remove(key, w) {
+			return WaitTimedOut
+		}
+		return WaitOK
+	}
+}
+
+// Wait block until element is notified or timeout, if element is equal to value
+// 	allowed only for Int32 & BigInt64 arrays over shared buffer
+// 	return WaitOK, WaitNotEqual or WaitTimedOut; use Forever timeout to wait without limit
+func (a atomics) Wait(t *TypedArray, index int, value interface{}, timeout time.Duration) (string, error) {
+	w, key, r, err := a.enqueue(t, index, value)
+	if w == nil {
+		return r, err
+	}
+	return await(key, w, timeout), nil
+}
+
+// WaitAsync is not blocking Wait; result is sent to returned channel
+func (a atomics) WaitAsync(t *TypedArray, index int, value interface{}, timeout time.Duration) (<-chan string, error) {
+	ch := make(chan string, 1)
+	w, key, r, err := a.enqueue(t, index, value)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		ch <- r
+		return ch, nil
+	}
+	go func() { ch <- await(key, w, timeout) }()
+	return ch, nil
+}
+
+// Notify wake up to count goroutines waiting on element; count < 0 wake all
+// 	return number of woken goroutines
+func (a atomics) Notify(t *TypedArray, index, count int) (int, error) {
+	if _, err := a.element(t, index); err != nil {
+		return 0, err
+	}
+	if !t.buffer.shared {
+		return 0, nil
+	}
+
+	key := waitKey{buffer: t.buffer, offset: t.byteOffset + index*t.kind.BytesPerElement()}
+	waiters.Lock()
+	defer waiters.Unlock()
+	list := waiters.lists[key]
+	if count < 0 || count > len(list) {
+		count = len(list)
+	}
+	for _, w := range list[:count] {
+		w.ch <- struct{}{}
+	}
+	if count == len(list) {
+		delete(waiters.lists, key)
+	} else {
+		waiters.lists[key] = list[count:]
+	}
+	return count, nil
+}
@@ -0,0 +1,155 @@
+package typedarray
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestAtomicsContention(t *testing.T) {
+	const workers, loops = 16, 1000
+	b := NewSharedArrayBuffer(24)
+	i8, _ := NewView(Int8, b, 0, 4)
+	u16, _ := NewView(Uint16, b, 4, 2)
+	i32, _ := NewView(Int32, b, 8, 2)
+	big, _ := NewView(BigInt64, b, 16, 1)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < loops; i++ {
+				// neighbour bytes in one word are changed concurrently
+				Atomics.Add(i8, w%4, 1)
+				Atomics.Add(u16, w%2, 3)
+				Atomics.Add(i32, 0, 2)
+				Atomics.Sub(i32, 1, 1)
+				Atomics.Add(big, 0, 5)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	got := func(t *TypedArray, i int) interface{} {
+		v, _ := Atomics.Load(t, i)
+		return v
+	}
+	// 4 workers per byte * 1000 = 4000, wrapped to int8
+	TestLog("Atomics", t, "int8", got(i8, 1), float64(int8(4000%256-256)), "int8 add wrap around")
+	TestLog("Atomics", t, "uint16", got(u16, 1), float64(uint16(8*loops*3)), "uint16 add")
+	TestLog("Atomics", t, "int32", got(i32, 0), float64(workers*loops*2), "int32 add")
+	TestLog("Atomics", t, "int32", got(i32, 1), float64(-workers*loops), "int32 sub")
+	TestLog("Atomics", t, "bigint64", got(big, 0), int64(workers*loops*5), "bigint64 add")
+}
+
+func TestAtomicsCompareExchange(t *testing.T) {
+	const workers = 32
+	b := NewSharedArrayBuffer(4)
+	lock, _ := NewView(Int32, b, 0, 1)
+
+	counter := 0
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				// spin lock
+				for {
+					old, _ := Atomics.CompareExchange(lock, 0, 0, 1)
+					if old == 0.0 {
+						break
+					}
+				}
+				counter++
+				Atomics.Store(lock, 0, 0)
+			}
+		}()
+	}
+	wg.Wait()
+	TestLog("CompareExchange", t, workers, counter, workers*100, "spin lock")
+}
+
+func TestAtomicsOperations(t *testing.T) {
+	a := From(Uint8, 0b1100, 200)
+	tests := []struct {
+		op          func() (interface{}, error)
+		want        interface{}
+		after       interface{}
+		index       int
+		description string
+	}{
+		{func() (interface{}, error) { return Atomics.And(a, 0, 0b1010) }, 12.0, 8.0, 0, "and"},
+		{func() (interface{}, error) { return Atomics.Or(a, 0, 0b0011) }, 8.0, 11.0, 0, "or"},
+		{func() (interface{}, error) { return Atomics.Xor(a, 0, 0b1111) }, 11.0, 4.0, 0, "xor"},
+		{func() (interface{}, error) { return Atomics.Exchange(a, 0, 7) }, 4.0, 7.0, 0, "exchange"},
+		{func() (interface{}, error) { return Atomics.CompareExchange(a, 0, 1, 9) }, 7.0, 7.0, 0, "compareExchange not equal"},
+		{func() (interface{}, error) { return Atomics.Add(a, 1, 100) }, 200.0, 44.0, 1, "add wrap around"},
+		{func() (interface{}, error) { return Atomics.Store(a, 1, 300) }, 44.0, 44.0, 1, "store wrap around"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.op()
+		TestLog("Atomics", t, tt.description, got, tt.want, tt.description)
+		TestLog("Atomics", t, tt.description, err, nil, tt.description+" error")
+		TestLog("Atomics", t, tt.description, a.Get(tt.index), tt.after, tt.description+" after")
+	}
+
+	_, err := Atomics.Add(New(Float32, 1), 0, 1)
+	_, ok := err.(*array.TypeError)
+	TestLog("Atomics", t, "float32", ok, true, "not integer array")
+	_, err = Atomics.Load(a, 2)
+	_, ok = err.(*array.RangeError)
+	TestLog("Atomics", t, "index", ok, true, "index out of range")
+	TestLog("Atomics", t, "lock free", Atomics.IsLockFree(4), true, "lock free")
+}
+
+func TestAtomicsWaitNotify(t *testing.T) {
+	b := NewSharedArrayBuffer(8)
+	i32, _ := NewView(Int32, b, 0, 2)
+
+	r, _ := Atomics.Wait(i32, 0, 1, Forever)
+	TestLog("Wait", t, 1, r, WaitNotEqual, "not equal")
+	r, _ = Atomics.Wait(i32, 0, 0, 10*time.Millisecond)
+	TestLog("Wait", t, 0, r, WaitTimedOut, "timed out")
+
+	const workers = 8
+	results := make(chan string, workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			r, _ := Atomics.Wait(i32, 1, 0, Forever)
+			results <- r
+		}()
+	}
+	woken := 0
+	for woken < workers {
+		n, _ := Atomics.Notify(i32, 1, 3)
+		woken += n
+		time.Sleep(time.Millisecond)
+	}
+	for w := 0; w < workers; w++ {
+		TestLog("Notify", t, w, <-results, WaitOK, "woken waiter")
+	}
+
+	ch, _ := Atomics.WaitAsync(i32, 0, 0, Forever)
+	for {
+		if n, _ := Atomics.Notify(i32, 0, -1); n == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	TestLog("WaitAsync", t, 0, <-ch, WaitOK, "async waiter")
+
+	ch, _ = Atomics.WaitAsync(i32, 0, 5, Forever)
+	TestLog("WaitAsync", t, 5, <-ch, WaitNotEqual, "async not equal")
+
+	_, err := Atomics.Wait(New(Int32, 1), 0, 0, 0)
+	_, ok := err.(*array.TypeError)
+	TestLog("Wait", t, "not shared", ok, true, "wait on not shared buffer")
+	_, err = Atomics.Wait(From(Int16, 0), 0, 0, 0)
+	_, ok = err.(*array.TypeError)
+	TestLog("Wait", t, "int16", ok, true, "wait on int16 array")
+}
@@ -0,0 +1,124 @@
+package typedarray
+
+import (
+	"unsafe"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// ArrayBuffer is raw binary data shared by views, like js ArrayBuffer
+type ArrayBuffer struct {
+	data      []byte
+	resizable bool
+	shared    bool
+	max       int
+}
+
+// alloc return zero bytes aligned to 8, so elements can be accessed atomically
+func alloc(length, capacity int) []byte {
+	if capacity == 0 {
+		return []byte{}
+	}
+	words := make([]uint64, (capacity+7)/8)
+	return unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), capacity)[:length]
+}
+
+// NewArrayBuffer return new zero filled fixed length ArrayBuffer
+func NewArrayBuffer(byteLength int) *ArrayBuffer {
+	if byteLength < 0 {
+		byteLength = 0
+	}
+	return &ArrayBuffer{data: alloc(byteLength, byteLength), max: byteLength}
+}
+
+// NewResizableArrayBuffer return new ArrayBuffer which can be resized up to maxByteLength
+func NewResizableArrayBuffer(byteLength, maxByteLength int) (*ArrayBuffer, error) {
+	if byteLength < 0 || byteLength > maxByteLength {
+		return nil, &array.RangeError{Message: "Invalid array buffer length"}
+	}
+	return &ArrayBuffer{data: alloc(byteLength, maxByteLength), resizable: true, max: maxByteLength}, nil
+}
+
+// ByteLength return length in bytes
+func (b *ArrayBuffer) ByteLength() int {
+	return len(b.data)
+}
+
+// MaxByteLength return max length in bytes; same as ByteLength for fixed length buffer
+func (b *ArrayBuffer) MaxByteLength() int {
+	return b.max
+}
+
+// NewSharedArrayBuffer return new zero filled buffer for sharing between goroutines, like js SharedArrayBuffer
+// 	use Atomics to access shared elements
+func NewSharedArrayBuffer(byteLength int) *ArrayBuffer {
+	b := NewArrayBuffer(byteLength)
+	b.shared = true
+	return b
+}
+
+// IsShared check is buffer created by NewSharedArrayBuffer
+func (b *ArrayBuffer) IsShared() bool {
+	return b.shared
+}
+
+// Resizable check can buffer be resized
+func (b *ArrayBuffer) Resizable() bool {
+	return b.resizable
+}
+
+// Resize change length of resizable buffer; new bytes are zero
+func (b *ArrayBuffer) Resize(newByteLength int) error {
+	if !b.resizable {
+		return &array.TypeError{Message: "Method ArrayBuffer.prototype.resize called on incompatible receiver"}
+	}
+	if newByteLength < 0 || newByteLength > b.max {
+		return &array.RangeError{Message: "ArrayBuffer.prototype.resize: Invalid length parameter"}
+	}
+	if newByteLength < len(b.data) {
+		// cut bytes must be zero when buffer grow again
+		tail := b.data[newByteLength:]
+		for i := range tail {
+			tail[i] = 0
+		}
+	}
+	b.data = b.data[:newByteLength]
+	return nil
+}
+
+// Slice return new buffer with copy of bytes between start & end
+// 	if start/end < 0, then count from end
+func (b *ArrayBuffer) Slice(start, end int) *ArrayBuffer {
+	s, e := relativeRange(start, end, len(b.data))
+	r := NewArrayBuffer(e - s)
+	r.shared = b.shared
+	copy(r.data, b.data[s:e])
+	return r
+}
+
+// Bytes return underlying bytes; changes are visible to all views
+func (b *ArrayBuffer) Bytes() []byte {
+	return b.data
+}
+
+// relativeRange return start & end clamped to [0, length], negative are counted from end
+func relativeRange(start, end, length int) (int, int) {
+	s, e := relativeIndex(start, length), relativeIndex(end, length)
+	if e < s {
+		e = s
+	}
+	return s, e
+}
+
+func relativeIndex(i, length int) int {
+	if i < 0 {
+		i += length
+		if i < 0 {
+			return 0
+		}
+	}
+	if i > length {
+		return length
+	}
+	return i
+}
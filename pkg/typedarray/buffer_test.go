@@ -0,0 +1,62 @@
+package typedarray
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestArrayBufferResize(t *testing.T) {
+	b, err := NewResizableArrayBuffer(4, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(b.Bytes(), []byte{1, 2, 3, 4})
+
+	TestLog("Resize", t, 8, b.Resize(8), nil, "grow")
+	TestLog("Resize", t, 8, b.Bytes(), []byte{1, 2, 3, 4, 0, 0, 0, 0}, "grown bytes are zero")
+	TestLog("Resize", t, 2, b.Resize(2), nil, "shrink")
+	b.Resize(4)
+	TestLog("Resize", t, 4, b.Bytes(), []byte{1, 2, 0, 0}, "regrown bytes are zero")
+
+	_, ok := b.Resize(9).(*array.RangeError)
+	TestLog("Resize", t, 9, ok, true, "grow over max")
+	_, ok = NewArrayBuffer(4).Resize(2).(*array.TypeError)
+	TestLog("Resize", t, 2, ok, true, "resize fixed buffer")
+	_, err = NewResizableArrayBuffer(4, 2)
+	_, ok = err.(*array.RangeError)
+	TestLog("NewResizableArrayBuffer", t, 4, ok, true, "length over max")
+}
+
+func TestArrayBufferSlice(t *testing.T) {
+	b := NewArrayBuffer(4)
+	copy(b.Bytes(), []byte{1, 2, 3, 4})
+	tests := []struct {
+		start, end  int
+		want        []byte
+		description string
+	}{
+		{1, 3, []byte{2, 3}, "in range"},
+		{-2, 4, []byte{3, 4}, "negative start"},
+		{0, -1, []byte{1, 2, 3}, "negative end"},
+		{3, 1, []byte{}, "end before start"},
+		{0, 10, []byte{1, 2, 3, 4}, "end out of range"},
+	}
+
+	for _, tt := range tests {
+		s := b.Slice(tt.start, tt.end)
+		TestLog("Slice", t, []int{tt.start, tt.end}, s.Bytes(), tt.want, tt.description)
+	}
+	b.Slice(0, 1).Bytes()[0] = 9
+	TestLog("Slice", t, "copy", b.Bytes()[0], byte(1), "slice is copy")
+}
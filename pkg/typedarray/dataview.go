@@ -0,0 +1,223 @@
+package typedarray
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+// DataView is view of ArrayBuffer with explicit byte order, like js DataView
+type DataView struct {
+	buffer     *ArrayBuffer
+	byteOffset int
+	byteLength int
+	tracking   bool
+}
+
+// NewDataView return new DataView over buffer from byteOffset
+// 	if byteLength < 0, view cover buffer to end; on resizable buffer such view track buffer length
+func NewDataView(buffer *ArrayBuffer, byteOffset, byteLength int) (*DataView, error) {
+	if byteOffset < 0 || byteOffset > buffer.ByteLength() {
+		return nil, &array.RangeError{Message: "Start offset " + conv.ToString(byteOffset) + " is outside the bounds of the buffer"}
+	}
+	d := &DataView{buffer: buffer, byteOffset: byteOffset, byteLength: byteLength}
+	if byteLength < 0 {
+		d.tracking = buffer.Resizable()
+		d.byteLength = buffer.ByteLength() - byteOffset
+	} else if byteOffset+byteLength > buffer.ByteLength() {
+		return nil, &array.RangeError{Message: "Invalid DataView length " + conv.ToString(byteLength)}
+	}
+	return d, nil
+}
+
+// Buffer return underlying buffer
+func (d *DataView) Buffer() *ArrayBuffer {
+	return d.buffer
+}
+
+// LengthTracking check is view length tracking length of resizable buffer
+func (d *DataView) LengthTracking() bool {
+	return d.tracking
+}
+
+// ByteOffset return offset in buffer
+func (d *DataView) ByteOffset() int {
+	return d.byteOffset
+}
+
+// ByteLength return length in bytes; 0 if view is out of buffer bounds
+func (d *DataView) ByteLength() int {
+	l := d.buffer.ByteLength()
+	if d.tracking {
+		if d.byteOffset > l {
+			return 0
+		}
+		return l - d.byteOffset
+	}
+	if d.byteOffset+d.byteLength > l {
+		return 0
+	}
+	return d.byteLength
+}
+
+func (d *DataView) bytes(byteOffset, size int) ([]byte, error) {
+	if byteOffset < 0 || byteOffset+size > d.ByteLength() {
+		return nil, &array.RangeError{Message: "Offset is outside the bounds of the DataView"}
+	}
+	off := d.byteOffset + byteOffset
+	return d.buffer.data[off : off+size], nil
+}
+
+func order(littleEndian bool) binary.ByteOrder {
+	if littleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// GetInt8 return int8 at byteOffset
+func (d *DataView) GetInt8(byteOffset int) (int8, error) {
+	b, err := d.bytes(byteOffset, 1)
+	if err != nil {
+		return 0, err
+	}
+	return int8(b[0]), nil
+}
+
+// GetUint8 return uint8 at byteOffset
+func (d *DataView) GetUint8(byteOffset int) (uint8, error) {
+	b, err := d.bytes(byteOffset, 1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// GetInt16 return int16 at byteOffset
+func (d *DataView) GetInt16(byteOffset int, littleEndian bool) (int16, error) {
+	v, err := d.GetUint16(byteOffset, littleEndian)
+	return int16(v), err
+}
+
+// GetUint16 return uint16 at byteOffset
+func (d *DataView) GetUint16(byteOffset int, littleEndian bool) (uint16, error) {
+	b, err := d.bytes(byteOffset, 2)
+	if err != nil {
+		return 0, err
+	}
+	return order(littleEndian).Uint16(b), nil
+}
+
+// GetInt32 return int32 at byteOffset
+func (d *DataView) GetInt32(byteOffset int, littleEndian bool) (int32, error) {
+	v, err := d.GetUint32(byteOffset, littleEndian)
+	return int32(v), err
+}
+
+// GetUint32 return uint32 at byteOffset
+func (d *DataView) GetUint32(byteOffset int, littleEndian bool) (uint32, error) {
+	b, err := d.bytes(byteOffset, 4)
+	if err != nil {
+		return 0, err
+	}
+	return order(littleEndian).Uint32(b), nil
+}
+
+// GetFloat32 return float32 at byteOffset
+func (d *DataView) GetFloat32(byteOffset int, littleEndian bool) (float32, error) {
+	v, err := d.GetUint32(byteOffset, littleEndian)
+	return math.Float32frombits(v), err
+}
+
+// GetFloat64 return float64 at byteOffset
+func (d *DataView) GetFloat64(byteOffset int, littleEndian bool) (float64, error) {
+	v, err := d.GetBigUint64(byteOffset, littleEndian)
+	return math.Float64frombits(v), err
+}
+
+// GetBigInt64 return int64 at byteOffset
+func (d *DataView) GetBigInt64(byteOffset int, littleEndian bool) (int64, error) {
+	v, err := d.GetBigUint64(byteOffset, littleEndian)
+	return int64(v), err
+}
+
+// GetBigUint64 return uint64 at byteOffset
+func (d *DataView) GetBigUint64(byteOffset int, littleEndian bool) (uint64, error) {
+	b, err := d.bytes(byteOffset, 8)
+	if err != nil {
+		return 0, err
+	}
+	return order(littleEndian).Uint64(b), nil
+}
+
+// SetInt8 set int8 at byteOffset
+func (d *DataView) SetInt8(byteOffset int, value int8) error {
+	return d.SetUint8(byteOffset, uint8(value))
+}
+
+// SetUint8 set uint8 at byteOffset
+func (d *DataView) SetUint8(byteOffset int, value uint8) error {
+	b, err := d.bytes(byteOffset, 1)
+	if err != nil {
+		return err
+	}
+	b[0] = value
+	return nil
+}
+
+// SetInt16 set int16 at byteOffset
+func (d *DataView) SetInt16(byteOffset int, value int16, littleEndian bool) error {
+	return d.SetUint16(byteOffset, uint16(value), littleEndian)
+}
+
+// SetUint16 set uint16 at byteOffset
+func (d *DataView) SetUint16(byteOffset int, value uint16, littleEndian bool) error {
+	b, err := d.bytes(byteOffset, 2)
+	if err != nil {
+		return err
+	}
+	order(littleEndian).PutUint16(b, value)
+	return nil
+}
+
+// SetInt32 set int32 at byteOffset
+func (d *DataView) SetInt32(byteOffset int, value int32, littleEndian bool) error {
+	return d.SetUint32(byteOffset, uint32(value), littleEndian)
+}
+
+// SetUint32 set uint32 at byteOffset
+func (d *DataView) SetUint32(byteOffset int, value uint32, littleEndian bool) error {
+	b, err := d.bytes(byteOffset, 4)
+	if err != nil {
+		return err
+	}
+	order(littleEndian).PutUint32(b, value)
+	return nil
+}
+
+// SetFloat32 set float32 at byteOffset
+func (d *DataView) SetFloat32(byteOffset int, value float32, littleEndian bool) error {
+	return d.SetUint32(byteOffset, math.Float32bits(value), littleEndian)
+}
+
+// SetFloat64 set float64 at byteOffset
+func (d *DataView) SetFloat64(byteOffset int, value float64, littleEndian bool) error {
+	return d.SetBigUint64(byteOffset, math.Float64bits(value), littleEndian)
+}
+
+// SetBigInt64 set int64 at byteOffset
+func (d *DataView) SetBigInt64(byteOffset int, value int64, littleEndian bool) error {
+	return d.SetBigUint64(byteOffset, uint64(value), littleEndian)
+}
+
+// SetBigUint64 set uint64 at byteOffset
+func (d *DataView) SetBigUint64(byteOffset int, value uint64, littleEndian bool) error {
+	b, err := d.bytes(byteOffset, 8)
+	if err != nil {
+		return err
+	}
+	order(littleEndian).PutUint64(b, value)
+	return nil
+}
@@ -0,0 +1,45 @@
+package typedarray
+
+import (
+	"math"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestDataView(t *testing.T) {
+	b := NewArrayBuffer(8)
+	d, _ := NewDataView(b, 0, -1)
+
+	d.SetUint16(0, 0x0102, false)
+	TestLog("DataView", t, "big endian", b.Bytes()[:2], []byte{1, 2}, "set uint16 big endian")
+	d.SetUint16(0, 0x0102, true)
+	TestLog("DataView", t, "little endian", b.Bytes()[:2], []byte{2, 1}, "set uint16 little endian")
+
+	d.SetInt32(4, -2, false)
+	v, _ := d.GetInt32(4, false)
+	TestLog("DataView", t, "int32", v, int32(-2), "int32 round trip")
+	u, _ := d.GetUint32(4, true)
+	TestLog("DataView", t, "uint32", u, uint32(0xfeffffff), "read other endianness")
+
+	d.SetFloat64(0, math.Pi, true)
+	f, _ := d.GetFloat64(0, true)
+	TestLog("DataView", t, "float64", f, math.Pi, "float64 round trip")
+
+	d.SetBigInt64(0, math.MinInt64, false)
+	i, _ := d.GetBigInt64(0, false)
+	TestLog("DataView", t, "bigint64", i, int64(math.MinInt64), "bigint64 round trip")
+
+	_, err := d.GetUint32(6, true)
+	_, ok := err.(*array.RangeError)
+	TestLog("DataView", t, 6, ok, true, "read out of bounds")
+	_, ok = d.SetInt8(8, 1).(*array.RangeError)
+	TestLog("DataView", t, 8, ok, true, "write out of bounds")
+
+	sub, _ := NewDataView(b, 2, 2)
+	sub.SetUint8(0, 7)
+	TestLog("DataView", t, "offset", b.Bytes()[2], byte(7), "view offset")
+	_, err = NewDataView(b, 4, 8)
+	_, ok = err.(*array.RangeError)
+	TestLog("DataView", t, "length", ok, true, "view out of buffer")
+}
@@ -0,0 +1,123 @@
+package typedarray
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+// Kind is element type of TypedArray
+type Kind int
+
+const (
+	Int8 Kind = iota
+	Uint8
+	Uint8Clamped
+	Int16
+	Uint16
+	Int32
+	Uint32
+	Float32
+	Float64
+	BigInt64
+	BigUint64
+)
+
+var kindNames = [...]string{
+	"Int8Array", "Uint8Array", "Uint8ClampedArray", "Int16Array", "Uint16Array",
+	"Int32Array", "Uint32Array", "Float32Array", "Float64Array", "BigInt64Array", "BigUint64Array",
+}
+
+var kindSizes = [...]int{1, 1, 1, 2, 2, 4, 4, 4, 8, 8, 8}
+
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// BytesPerElement return element size in bytes
+func (k Kind) BytesPerElement() int {
+	return kindSizes[k]
+}
+
+// IsBigInt check is elements int64/uint64, like js BigInt arrays
+func (k Kind) IsBigInt() bool {
+	return k == BigInt64 || k == BigUint64
+}
+
+// toNumber return float64 of go value, like js ToNumber; unsupported value is NaN
+func toNumber(v interface{}) float64 {
+	if f, ok := conv.ToNumber(v); ok {
+		return f
+	}
+	return math.NaN()
+}
+
+var two64 = new(big.Int).Lsh(big.NewInt(1), 64)
+
+// toBigUint64 return low 64 bits of go integer, like js BigInt.asUintN(64)
+func toBigUint64(v interface{}) uint64 {
+	switch v := v.(type) {
+	case int:
+		return uint64(v)
+	case int8:
+		return uint64(v)
+	case int16:
+		return uint64(v)
+	case int32:
+		return uint64(v)
+	case int64:
+		return uint64(v)
+	case uint:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	case *big.Int:
+		return new(big.Int).Mod(v, two64).Uint64()
+	}
+	return toIntN(toNumber(v), 64)
+}
+
+// bigInt return value of go integer or *big.Int
+func bigInt(v interface{}) (*big.Int, bool) {
+	if b, ok := v.(*big.Int); ok {
+		return b, b != nil
+	}
+	return conv.Integer(v)
+}
+
+// toIntN return f wrapped to bits width, like js ToInt8/ToUint16/...
+func toIntN(f float64, bits uint) uint64 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	f = math.Trunc(f)
+	if bits == 64 {
+		m := new(big.Float).SetFloat64(f)
+		i, _ := m.Int(nil)
+		return new(big.Int).Mod(i, two64).Uint64()
+	}
+	mod := math.Exp2(float64(bits))
+	f = math.Mod(f, mod)
+	if f < 0 {
+		f += mod
+	}
+	return uint64(f)
+}
+
+// toUint8Clamp return f clamped to [0, 255] with round half to even, like js ToUint8Clamp
+func toUint8Clamp(f float64) uint8 {
+	switch {
+	case math.IsNaN(f) || f <= 0:
+		return 0
+	case f >= 255:
+		return 255
+	}
+	return uint8(math.RoundToEven(f))
+}
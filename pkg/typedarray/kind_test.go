@@ -0,0 +1,65 @@
+package typedarray
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestKindConversion(t *testing.T) {
+	big1 := new(big.Int).Lsh(big.NewInt(1), 64)
+	tests := []struct {
+		kind        Kind
+		incoming    interface{}
+		want        interface{}
+		description string
+	}{
+		{Int8, 127, 127.0, "int8 max"},
+		{Int8, 128, -128.0, "int8 wrap"},
+		{Int8, -129, 127.0, "int8 wrap negative"},
+		{Uint8, 256, 0.0, "uint8 wrap"},
+		{Uint8, -1, 255.0, "uint8 negative"},
+		{Uint8, 1.9, 1.0, "uint8 truncate"},
+		{Uint8, math.NaN(), 0.0, "uint8 NaN"},
+		{Uint8, math.Inf(1), 0.0, "uint8 Infinity"},
+		{Uint8Clamped, 300, 255.0, "clamped max"},
+		{Uint8Clamped, -5, 0.0, "clamped min"},
+		{Uint8Clamped, 1.5, 2.0, "clamped round half to even up"},
+		{Uint8Clamped, 2.5, 2.0, "clamped round half to even down"},
+		{Uint8Clamped, 2.6, 3.0, "clamped round"},
+		{Int16, 40000, -25536.0, "int16 wrap"},
+		{Uint16, -1, 65535.0, "uint16 negative"},
+		{Int32, 2147483648.0, -2147483648.0, "int32 wrap"},
+		{Uint32, -1, 4294967295.0, "uint32 negative"},
+		{Float32, 0.1, float64(float32(0.1)), "float32 rounding"},
+		{Float64, "1.5", 1.5, "float64 from string"},
+		{Float64, "x", math.NaN(), "float64 NaN string"},
+		{Uint8, "0x10", 16.0, "uint8 from hex string"},
+		{Float64, "  12  ", 12.0, "float64 from padded string"},
+		{Float64, "\u00a012\ufeff", 12.0, "float64 from string with js whitespace"},
+		{Float64, "Infinity", math.Inf(1), "float64 Infinity string"},
+		{Float64, "inf", math.NaN(), "float64 go-only inf string"},
+		{Float64, "1_0", math.NaN(), "float64 go-only underscore string"},
+		{Float64, "0x1p4", math.NaN(), "float64 go-only hex float string"},
+		{Float64, "", 0.0, "float64 empty string"},
+		{BigInt64, uint64(math.MaxUint64), int64(-1), "bigint64 wrap"},
+		{BigInt64, new(big.Int).Add(big1, big.NewInt(5)), int64(5), "bigint64 from big"},
+		{BigUint64, -1, uint64(math.MaxUint64), "biguint64 negative"},
+		{BigUint64, big.NewInt(-2), uint64(math.MaxUint64 - 1), "biguint64 negative big"},
+	}
+
+	for _, tt := range tests {
+		got := From(tt.kind, tt.incoming).Get(0)
+		if f, ok := tt.want.(float64); ok && math.IsNaN(f) {
+			TestLog("Conversion", t, tt.incoming, math.IsNaN(got.(float64)), true, tt.description)
+			continue
+		}
+		TestLog("Conversion", t, tt.incoming, got, tt.want, tt.description)
+	}
+}
+
+func TestKind(t *testing.T) {
+	TestLog("Kind", t, Uint8Clamped, Uint8Clamped.String(), "Uint8ClampedArray", "name")
+	TestLog("Kind", t, Float64, Float64.BytesPerElement(), 8, "size")
+	TestLog("Kind", t, BigUint64, BigUint64.IsBigInt(), true, "bigint kind")
+}
@@ -0,0 +1,384 @@
+package typedarray
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+// TypedArray is view of ArrayBuffer as array of numbers, like js TypedArray
+// 	elements are float64, or int64/uint64 for BigInt64/BigUint64 kinds
+// 	elements are stored in little endian byte order
+type TypedArray struct {
+	kind       Kind
+	buffer     *ArrayBuffer
+	byteOffset int
+	length     int
+	tracking   bool
+}
+
+// New return new zero filled TypedArray with own buffer
+func New(kind Kind, length int) *TypedArray {
+	if length < 0 {
+		length = 0
+	}
+	return &TypedArray{kind: kind, buffer: NewArrayBuffer(length * kind.BytesPerElement()), length: length}
+}
+
+// NewView return new TypedArray over buffer from byteOffset
+// 	if length < 0, view cover buffer to end; on resizable buffer such view track buffer length
+func NewView(kind Kind, buffer *ArrayBuffer, byteOffset, length int) (*TypedArray, error) {
+	size := kind.BytesPerElement()
+	if byteOffset < 0 || byteOffset%size != 0 {
+		return nil, &array.RangeError{Message: "start offset of " + kind.String() + " should be a multiple of " + conv.ToString(size)}
+	}
+	if byteOffset > buffer.ByteLength() {
+		return nil, &array.RangeError{Message: "Start offset " + conv.ToString(byteOffset) + " is outside the bounds of the buffer"}
+	}
+
+	t := &TypedArray{kind: kind, buffer: buffer, byteOffset: byteOffset, length: length}
+	if length >= 0 {
+		if byteOffset+length*size > buffer.ByteLength() {
+			return nil, &array.RangeError{Message: "Invalid typed array length: " + conv.ToString(length)}
+		}
+		return t, nil
+	}
+
+	if buffer.Resizable() {
+		t.tracking = true
+		return t, nil
+	}
+	if (buffer.ByteLength()-byteOffset)%size != 0 {
+		return nil, &array.RangeError{Message: "byte length of " + kind.String() + " should be a multiple of " + conv.ToString(size)}
+	}
+	t.length = (buffer.ByteLength() - byteOffset) / size
+	return t, nil
+}
+
+// From return new TypedArray with data converted to kind
+func From(kind Kind, data ...interface{}) *TypedArray {
+	t := New(kind, len(data))
+	for i, v := range data {
+		t.Set(i, v)
+	}
+	return t
+}
+
+// FromArray return new TypedArray with Array elements converted to kind
+func FromArray(kind Kind, arr *array.Array) *TypedArray {
+	t := New(kind, len(arr.Items))
+	for i, v := range arr.Items {
+		t.Set(i, v.Data)
+	}
+	return t
+}
+
+// Kind return element kind
+func (t *TypedArray) Kind() Kind {
+	return t.kind
+}
+
+// Buffer return underlying buffer
+func (t *TypedArray) Buffer() *ArrayBuffer {
+	return t.buffer
+}
+
+// LengthTracking check is view length tracking length of resizable buffer
+func (t *TypedArray) LengthTracking() bool {
+	return t.tracking
+}
+
+// ByteOffset return offset in buffer; 0 if view is out of buffer bounds
+func (t *TypedArray) ByteOffset() int {
+	if t.outOfBounds() {
+		return 0
+	}
+	return t.byteOffset
+}
+
+// Length return elements count; 0 if view is out of buffer bounds
+func (t *TypedArray) Length() int {
+	if t.outOfBounds() {
+		return 0
+	}
+	if t.tracking {
+		return (t.buffer.ByteLength() - t.byteOffset) / t.kind.BytesPerElement()
+	}
+	return t.length
+}
+
+// ByteLength return length in bytes
+func (t *TypedArray) ByteLength() int {
+	return t.Length() * t.kind.BytesPerElement()
+}
+
+func (t *TypedArray) outOfBounds() bool {
+	if t.tracking {
+		return t.byteOffset > t.buffer.ByteLength()
+	}
+	return t.byteOffset+t.length*t.kind.BytesPerElement() > t.buffer.ByteLength()
+}
+
+// Get return element at index or nil if index is out of range
+func (t *TypedArray) Get(index int) interface{} {
+	if index < 0 || index >= t.Length() {
+		return nil
+	}
+	return t.get(index)
+}
+
+// At return element at index, negative index count from end
+func (t *TypedArray) At(index int) interface{} {
+	if index < 0 {
+		index += t.Length()
+	}
+	return t.Get(index)
+}
+
+func (t *TypedArray) bytes(index int) []byte {
+	size := t.kind.BytesPerElement()
+	off := t.byteOffset + index*size
+	return t.buffer.data[off : off+size]
+}
+
+func (t *TypedArray) get(index int) interface{} {
+	b := t.bytes(index)
+	switch t.kind {
+	case Int8:
+		return float64(int8(b[0]))
+	case Uint8, Uint8Clamped:
+		return float64(b[0])
+	case Int16:
+		return float64(int16(binary.LittleEndian.Uint16(b)))
+	case Uint16:
+		return float64(binary.LittleEndian.Uint16(b))
+	case Int32:
+		return float64(int32(binary.LittleEndian.Uint32(b)))
+	case Uint32:
+		return float64(binary.LittleEndian.Uint32(b))
+	case Float32:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	case Float64:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	case BigInt64:
+		return int64(binary.LittleEndian.Uint64(b))
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+// Set convert data to element kind and set it at index
+// 	integer kinds wrap around, Uint8Clamped clamp to [0, 255]
+// 	index out of range is ignored, like in js
+func (t *TypedArray) Set(index int, data interface{}) {
+	if index < 0 || index >= t.Length() {
+		return
+	}
+	t.set(index, data)
+}
+
+func (t *TypedArray) set(index int, data interface{}) {
+	b := t.bytes(index)
+	switch t.kind {
+	case Int8, Uint8:
+		b[0] = uint8(toIntN(toNumber(data), 8))
+	case Uint8Clamped:
+		b[0] = toUint8Clamp(toNumber(data))
+	case Int16, Uint16:
+		binary.LittleEndian.PutUint16(b, uint16(toIntN(toNumber(data), 16)))
+	case Int32, Uint32:
+		binary.LittleEndian.PutUint32(b, uint32(toIntN(toNumber(data), 32)))
+	case Float32:
+		binary.LittleEndian.PutUint32(b, math.Float32bits(float32(toNumber(data))))
+	case Float64:
+		binary.LittleEndian.PutUint64(b, math.Float64bits(toNumber(data)))
+	default:
+		binary.LittleEndian.PutUint64(b, toBigUint64(data))
+	}
+}
+
+// Fill fill all element equal to data
+func (t *TypedArray) Fill(data interface{}) *TypedArray {
+	for i, n := 0, t.Length(); i < n; i++ {
+		t.set(i, data)
+	}
+	return t
+}
+
+// Map return new typed array of same kind; elements maked in callback
+func (t *TypedArray) Map(callback func(value interface{}, index int, array *TypedArray) interface{}) *TypedArray {
+	n := t.Length()
+	r := New(t.kind, n)
+	for i := 0; i < n; i++ {
+		r.set(i, callback(t.get(i), i, t))
+	}
+	return r
+}
+
+// Sort sort elements in place
+// 	if compareFunction is nil, elements are sorted by numeric value, NaN at the end
+func (t *TypedArray) Sort(compareFunction func(a, b interface{}) int) *TypedArray {
+	values := t.values()
+	if compareFunction == nil {
+		compareFunction = compareNumbers
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return compareFunction(values[i], values[j]) < 0
+	})
+	for i, v := range values {
+		t.set(i, v)
+	}
+	return t
+}
+
+func compareNumbers(a, b interface{}) int {
+	switch x := a.(type) {
+	case int64:
+		y := b.(int64)
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	case uint64:
+		y := b.(uint64)
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	}
+
+	x, y := a.(float64), b.(float64)
+	switch {
+	case math.IsNaN(x) && math.IsNaN(y):
+		return 0
+	case math.IsNaN(x):
+		return 1
+	case math.IsNaN(y), x < y:
+		return -1
+	case x > y:
+		return 1
+	case x == 0 && y == 0 && math.Signbit(x) != math.Signbit(y):
+		// -0 before +0
+		if math.Signbit(x) {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+func (t *TypedArray) values() []interface{} {
+	n := t.Length()
+	values := make([]interface{}, n)
+	for i := range values {
+		values[i] = t.get(i)
+	}
+	return values
+}
+
+// IndexOf return index of first element strictly equal to data or -1
+// 	if fromIndex < 0, then count from end
+func (t *TypedArray) IndexOf(data interface{}, fromIndex int) int {
+	n := t.Length()
+	for i := relativeIndex(fromIndex, n); i < n; i++ {
+		if t.equal(t.get(i), data) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf return index of last element strictly equal to data or -1
+// 	search goes back from fromIndex; if fromIndex < 0, then count from end
+func (t *TypedArray) LastIndexOf(data interface{}, fromIndex int) int {
+	n := t.Length()
+	i := fromIndex
+	if i < 0 {
+		i += n
+	} else if i >= n {
+		i = n - 1
+	}
+	for ; i >= 0; i-- {
+		if t.equal(t.get(i), data) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Includes check is have element equal to data; NaN is found too
+// 	if fromIndex < 0, then count from end
+func (t *TypedArray) Includes(data interface{}, fromIndex int) bool {
+	n := t.Length()
+	f, ok := conv.Number(data)
+	nan := !t.kind.IsBigInt() && ok && math.IsNaN(f)
+	for i := relativeIndex(fromIndex, n); i < n; i++ {
+		v := t.get(i)
+		if t.equal(v, data) || (nan && math.IsNaN(v.(float64))) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal check is element v strictly equal to data, like js ===
+// 	data isn't converted: number kinds match go numbers, bigint kinds match go integers & *big.Int
+func (t *TypedArray) equal(v, data interface{}) bool {
+	switch x := v.(type) {
+	case int64:
+		b, ok := bigInt(data)
+		return ok && b.IsInt64() && b.Int64() == x
+	case uint64:
+		b, ok := bigInt(data)
+		return ok && b.IsUint64() && b.Uint64() == x
+	}
+	f, ok := conv.Number(data)
+	return ok && v.(float64) == f
+}
+
+// Slice return new typed array with copy of elements between start & end
+// 	if start/end < 0, then count from end
+func (t *TypedArray) Slice(start, end int) *TypedArray {
+	s, e := relativeRange(start, end, t.Length())
+	r := New(t.kind, e-s)
+	size := t.kind.BytesPerElement()
+	copy(r.buffer.data, t.buffer.data[t.byteOffset+s*size:t.byteOffset+e*size])
+	return r
+}
+
+// Subarray return new view of same buffer between start & end
+// 	if start/end < 0, then count from end
+func (t *TypedArray) Subarray(start, end int) *TypedArray {
+	s, e := relativeRange(start, end, t.Length())
+	return &TypedArray{
+		kind:       t.kind,
+		buffer:     t.buffer,
+		byteOffset: t.byteOffset + s*t.kind.BytesPerElement(),
+		length:     e - s,
+	}
+}
+
+// Join return elements joined by separator string
+// 	if separator is empty, then it's ","
+func (t *TypedArray) Join(separator string) string {
+	if separator == "" {
+		separator = ","
+	}
+	parts := make([]string, t.Length())
+	for i := range parts {
+		parts[i] = conv.ToString(t.get(i))
+	}
+	return strings.Join(parts, separator)
+}
+
+// ToArray return new Array with elements
+func (t *TypedArray) ToArray() *array.Array {
+	return array.MakeArray(t.values()...)
+}
@@ -0,0 +1,119 @@
+package typedarray
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestTypedArraySharedBuffer(t *testing.T) {
+	b := NewArrayBuffer(8)
+	u8, _ := NewView(Uint8, b, 0, -1)
+	u16, _ := NewView(Uint16, b, 2, 2)
+	f32, _ := NewView(Float32, b, 4, 1)
+
+	u16.Set(0, 0x0102)
+	TestLog("View", t, "uint16", u8.Get(2), 2.0, "little endian low byte")
+	TestLog("View", t, "uint16", u8.Get(3), 1.0, "little endian high byte")
+
+	f32.Set(0, 1)
+	TestLog("View", t, "float32", u8.Subarray(4, 8).ToArray(), array.MakeArray(0.0, 0.0, 128.0, 63.0), "float32 bytes")
+	TestLog("View", t, "length", []int{u8.Length(), u16.Length(), f32.Length()}, []int{8, 2, 1}, "view lengths")
+	TestLog("View", t, "offset", u16.ByteOffset(), 2, "view offset")
+
+	_, err := NewView(Uint32, b, 2, -1)
+	_, ok := err.(*array.RangeError)
+	TestLog("View", t, "offset", ok, true, "unaligned offset")
+	_, err = NewView(Uint32, b, 4, 2)
+	_, ok = err.(*array.RangeError)
+	TestLog("View", t, "length", ok, true, "length out of buffer")
+}
+
+func TestTypedArrayResizable(t *testing.T) {
+	b, _ := NewResizableArrayBuffer(4, 16)
+	tracking, _ := NewView(Uint16, b, 0, -1)
+	fixed, _ := NewView(Uint8, b, 2, 2)
+
+	TestLog("Resizable", t, 4, tracking.Length(), 2, "tracking length")
+	b.Resize(10)
+	TestLog("Resizable", t, 10, tracking.Length(), 5, "tracking length after grow")
+	b.Resize(3)
+	TestLog("Resizable", t, 3, tracking.Length(), 1, "tracking length after shrink")
+	TestLog("Resizable", t, 3, fixed.Length(), 0, "fixed view out of bounds")
+	TestLog("Resizable", t, 3, fixed.Get(0), nil, "out of bounds get")
+	b.Resize(4)
+	TestLog("Resizable", t, 4, fixed.Length(), 2, "fixed view back in bounds")
+}
+
+func TestTypedArrayMethods(t *testing.T) {
+	a := From(Int16, 5, -1, 3)
+
+	TestLog("Map", t, a.ToArray(), a.Map(func(v interface{}, i int, arr *TypedArray) interface{} {
+		return v.(float64) * 10000
+	}).ToArray(), array.MakeArray(-15536.0, -10000.0, 30000.0), "map wrap around")
+	TestLog("Sort", t, a.ToArray(), From(Int16, 5, -1, 3).Sort(nil).ToArray(), array.MakeArray(-1.0, 3.0, 5.0), "numeric sort")
+	TestLog("Sort", t, a.ToArray(), From(Int16, 5, -1, 3).Sort(func(a, b interface{}) int {
+		return int(b.(float64) - a.(float64))
+	}).ToArray(), array.MakeArray(5.0, 3.0, -1.0), "custom sort")
+	TestLog("IndexOf", t, a.ToArray(), a.IndexOf(3, 0), 2, "indexOf")
+	TestLog("IndexOf", t, a.ToArray(), a.IndexOf(5, -2), -1, "indexOf from end")
+	TestLog("LastIndexOf", t, a.ToArray(), From(Int16, 3, 5, 3).LastIndexOf(3, 10), 2, "lastIndexOf")
+	TestLog("LastIndexOf", t, a.ToArray(), From(Int16, 3, 5, 3).LastIndexOf(3, -2), 0, "lastIndexOf from end")
+	TestLog("Fill", t, a.ToArray(), New(Uint8, 3).Fill(257).ToArray(), array.MakeArray(1.0, 1.0, 1.0), "fill wrap around")
+	TestLog("Join", t, a.ToArray(), a.Join(""), "5,-1,3", "join")
+	TestLog("At", t, a.ToArray(), a.At(-1), 3.0, "at from end")
+
+	s := a.Slice(1, 3)
+	s.Set(0, 100)
+	TestLog("Slice", t, a.ToArray(), a.Get(1), -1.0, "slice is copy")
+	sub := a.Subarray(-2, 3)
+	sub.Set(0, 100)
+	TestLog("Subarray", t, a.ToArray(), a.Get(1), 100.0, "subarray share buffer")
+	TestLog("Subarray", t, a.ToArray(), sub.ByteOffset(), 2, "subarray offset")
+}
+
+func TestTypedArrayStrictEquality(t *testing.T) {
+	big1 := new(big.Int).Lsh(big.NewInt(1), 64)
+	tests := []struct {
+		arr         *TypedArray
+		data        interface{}
+		index       int
+		description string
+	}{
+		{From(Uint8, 0, 1, 2), 1, 1, "number"},
+		{From(Uint8, 0, 1, 2), 1.0, 1, "float number"},
+		{From(Uint8, 0, 1, 2), "1", -1, "string isn't converted"},
+		{From(Uint8, 0, 1, 2), true, -1, "bool isn't converted"},
+		{From(Uint8, 0, 1, 2), nil, -1, "nil isn't zero"},
+		{From(Uint8, 0, 1, 2), 257, -1, "number isn't wrapped"},
+		{From(BigInt64, -1, 5), int64(5), 1, "bigint"},
+		{From(BigInt64, -1, 5), big.NewInt(-1), 0, "big.Int"},
+		{From(BigInt64, -1, 5), 5.0, -1, "number isn't bigint"},
+		{From(BigInt64, -1, 5), "5", -1, "string isn't bigint"},
+		{From(BigUint64, -1), -1, -1, "bigint isn't wrapped"},
+		{From(BigUint64, -1), new(big.Int).Sub(big1, big.NewInt(1)), 0, "big.Int max"},
+	}
+
+	for _, tt := range tests {
+		TestLog("IndexOf", t, tt.data, tt.arr.IndexOf(tt.data, 0), tt.index, tt.description)
+		TestLog("LastIndexOf", t, tt.data, tt.arr.LastIndexOf(tt.data, -1), tt.index, tt.description)
+		TestLog("Includes", t, tt.data, tt.arr.Includes(tt.data, 0), tt.index >= 0, tt.description)
+	}
+	TestLog("IndexOf", t, "NaN", From(Float64, math.NaN()).IndexOf(math.NaN(), 0), -1, "NaN isn't strictly equal")
+	TestLog("Includes", t, "NaN", From(Float64, math.NaN()).Includes(math.NaN(), 0), true, "includes find NaN")
+	TestLog("Includes", t, "NaN", From(Float64, math.NaN()).Includes("NaN", 0), false, "NaN string isn't converted")
+}
+
+func TestTypedArraySortSpecial(t *testing.T) {
+	f := From(Float64, math.NaN(), 1, math.Copysign(0, -1), 0, -math.MaxFloat64).Sort(nil)
+	got := f.ToArray()
+	TestLog("Sort", t, "floats", got.Items[0].Data, -math.MaxFloat64, "min first")
+	TestLog("Sort", t, "floats", math.Signbit(got.Items[1].Data.(float64)), true, "-0 before +0")
+	TestLog("Sort", t, "floats", math.IsNaN(got.Items[4].Data.(float64)), true, "NaN last")
+	TestLog("Includes", t, "floats", f.Includes(math.NaN(), 0), true, "includes NaN")
+
+	b := From(BigUint64, uint64(math.MaxUint64), 1).Sort(nil)
+	TestLog("Sort", t, "biguint64", b.ToArray(), array.MakeArray(uint64(1), uint64(math.MaxUint64)), "bigint sort")
+}
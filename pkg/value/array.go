@@ -0,0 +1,38 @@
+package value
+
+import "github.com/miron-developer/golang-js-utils/pkg/array"
+
+// ArraySemantics is array.Semantics of js, where every element is Value
+// 	elements are compared by IsStrictlyEqual & SameValueZero & converted by ToString
+var ArraySemantics array.Semantics = arraySemantics{}
+
+type arraySemantics struct{}
+
+func (arraySemantics) Wrap(data interface{}) interface{} {
+	return Of(data)
+}
+
+func (arraySemantics) Undefined() interface{} {
+	return Undefined
+}
+
+func (arraySemantics) Nullish(data interface{}) bool {
+	return Of(data).IsNullish()
+}
+
+func (arraySemantics) ToString(data interface{}) (string, error) {
+	return ToString(Of(data))
+}
+
+func (arraySemantics) StrictEqual(a, b interface{}) bool {
+	return IsStrictlyEqual(Of(a), Of(b))
+}
+
+func (arraySemantics) SameValueZero(a, b interface{}) bool {
+	return SameValueZero(Of(a), Of(b))
+}
+
+// NewArray return Array with js semantics of elements, where items are converted by Of
+func NewArray(items ...interface{}) *array.Array {
+	return array.NewArray().SetSemantics(ArraySemantics).Push(items...)
+}
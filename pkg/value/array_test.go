@@ -0,0 +1,75 @@
+package value
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestNewArray(t *testing.T) {
+	arr := NewArray(1, "a", nil)
+	TestLog("TestNewArray", t, "[1, a, nil]", arr.Items[0].Data, Number(1), "items are wrapped")
+	TestLog("TestNewArray", t, "[1, a, nil]", arr.Items[2].Data, Null, "nil is null")
+
+	arr.SetLength(4)
+	TestLog("TestNewArray", t, "length 4", arr.Items[3].Data, Undefined, "holes are undefined")
+
+	arr.SetAt(0, 2)
+	TestLog("TestNewArray", t, "arr[0] = 2", arr.Items[0].Data, Number(2), "assigned data is wrapped")
+}
+
+func TestArraySemantics(t *testing.T) {
+	arr := NewArray(1, math.NaN(), math.Copysign(0, -1), "1", nil, Undefined)
+
+	TestLog("TestArraySemantics", t, "indexOf 1.0", arr.IndexOf(1.0, 0), 0, "int & float are same number")
+	TestLog("TestArraySemantics", t, "indexOf NaN", arr.IndexOf(math.NaN(), 0), -1, "NaN is not strictly equal")
+	TestLog("TestArraySemantics", t, "includes NaN", arr.Includes(math.NaN(), 0), true, "includes use SameValueZero")
+	TestLog("TestArraySemantics", t, "indexOf 0", arr.IndexOf(0, 0), 2, "+0 === -0")
+	TestLog("TestArraySemantics", t, "lastIndexOf 1", arr.LastIndexOf("1", 0), 3, "string is not number")
+	TestLog("TestArraySemantics", t, "join", arr.Join(","), "1,NaN,0,1,,", "null & undefined are empty")
+
+	sorted := NewArray(10, Undefined, 9, nil, true, "b").Sort(nil)
+	TestLog("TestArraySemantics", t, "sort", sorted.Join(","), "10,9,b,,true,", "sorted by strings, null is \"null\", undefined last")
+	TestLog("TestArraySemantics", t, "sort", sorted.Items[5].Data, Undefined, "undefined last")
+
+	mapped := arr.Map(func(v array.ArrayItem, i int, a *array.Array) array.ArrayItem {
+		return array.ArrayItem{Data: i}
+	})
+	TestLog("TestArraySemantics", t, "map", mapped.Items[1].Data, Number(1), "derived array keeps semantics")
+	TestLog("TestArraySemantics", t, "slice", arr.NewSlice(0, 1).Semantics(), ArraySemantics, "slice keeps semantics")
+}
+
+func TestArraySemanticsError(t *testing.T) {
+	arr := NewArray(1, NewSymbol("s"))
+	TestLog("TestArraySemanticsError", t, "[1, Symbol(s)]", arr.Join("-"), "1-", "symbol can't be joined")
+	TestLog("TestArraySemanticsError", t, "[1, Symbol(s)]", arr.Err() != nil, true, "error is recorded")
+}
+
+func TestSetSemantics(t *testing.T) {
+	arr := array.MakeNArray(1).Push(2).SetSemantics(ArraySemantics)
+	TestLog("TestSetSemantics", t, "[nil, 2]", arr.Items[0].Data, Undefined, "nil element becomes undefined")
+	TestLog("TestSetSemantics", t, "[nil, 2]", arr.Items[1].Data, Number(2), "element is wrapped")
+
+	arr.SetSemantics(nil)
+	TestLog("TestSetSemantics", t, "nil", arr.Semantics(), nil, "default semantics")
+}
+
+func TestArrayItemCoercion(t *testing.T) {
+	var _ array.Coercible = Value{}
+	arr := NewArray(" 12 ", Undefined, math.NaN(), 2.5, NewArray(3))
+
+	TestLog("TestArrayItemCoercion", t, "\" 12 \"", arr.Items[0].Number(), 12.0, "string Value")
+	TestLog("TestArrayItemCoercion", t, "undefined", math.IsNaN(arr.Items[1].Number()), true, "undefined is NaN, unlike nil")
+	TestLog("TestArrayItemCoercion", t, "undefined", arr.Items[1].String(), "undefined", "undefined")
+	TestLog("TestArrayItemCoercion", t, "NaN", arr.Items[2].Bool(), false, "NaN is falsy")
+	TestLog("TestArrayItemCoercion", t, "2.5", arr.Items[3].BigInt(), (*big.Int)(nil), "fraction can't be bigint")
+	TestLog("TestArrayItemCoercion", t, "\" 12 \"", arr.Items[0].BigInt(), big.NewInt(12), "string is bigint")
+	TestLog("TestArrayItemCoercion", t, "[3]", arr.Items[4].Array().Items[0].Int32(), int32(3), "nested Array Value")
+
+	s, err := arr.Items[0].AsString()
+	TestLog("TestArrayItemCoercion", t, "\" 12 \"", []interface{}{s, err}, []interface{}{" 12 ", nil}, "strict string")
+	_, err = arr.Items[0].AsNumber()
+	TestLog("TestArrayItemCoercion", t, "\" 12 \"", err != nil, true, "strict number")
+}
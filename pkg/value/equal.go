@@ -0,0 +1,104 @@
+package value
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+// IsStrictlyEqual check is a === b, like js IsStrictlyEqual
+// 	NaN is not equal to itself, +0 is equal to -0, objects are equal if they are same go value
+func IsStrictlyEqual(a, b Value) bool {
+	if a.kind == KindNumber && b.kind == KindNumber {
+		return a.n == b.n
+	}
+	return SameValue(a, b)
+}
+
+// SameValue check is a & b same value, like js Object.is
+// 	NaN is equal to NaN, +0 is not equal to -0
+func SameValue(a, b Value) bool {
+	if a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case KindUndefined, KindNull:
+		return true
+	case KindBoolean:
+		return a.b == b.b
+	case KindNumber:
+		if math.IsNaN(a.n) {
+			return math.IsNaN(b.n)
+		}
+		return a.n == b.n && math.Signbit(a.n) == math.Signbit(b.n)
+	case KindBigInt:
+		return a.ref.(*big.Int).Cmp(b.ref.(*big.Int)) == 0
+	case KindString:
+		return a.s == b.s
+	case KindObject:
+		return array.SameValueZero(a.ref, b.ref)
+	}
+	return a.ref == b.ref
+}
+
+// SameValueZero check is a & b same value, like js SameValueZero
+// 	NaN is equal to NaN, +0 is equal to -0
+func SameValueZero(a, b Value) bool {
+	if a.kind == KindNumber && b.kind == KindNumber && a.n == 0 && b.n == 0 {
+		return true
+	}
+	return SameValue(a, b)
+}
+
+// IsLooselyEqual check is a == b, like js IsLooselyEqual
+// 	operands of different types are converted to primitives & numbers
+// 	return error of conversion of object to primitive
+func IsLooselyEqual(a, b Value) (bool, error) {
+	switch {
+	case a.kind == b.kind || a.IsObject() && b.IsObject():
+		return IsStrictlyEqual(a, b), nil
+	case a.IsNullish() || b.IsNullish():
+		return a.IsNullish() && b.IsNullish(), nil
+	case a.kind == KindNumber && b.kind == KindString:
+		return a.n == conv.StringToNumber(b.s), nil
+	case a.kind == KindString && b.kind == KindNumber:
+		return IsLooselyEqual(b, a)
+	case a.kind == KindBigInt && b.kind == KindString:
+		n, ok := conv.StringToBigInt(b.s)
+		return ok && n.Cmp(a.ref.(*big.Int)) == 0, nil
+	case a.kind == KindString && b.kind == KindBigInt:
+		return IsLooselyEqual(b, a)
+	case a.kind == KindBoolean:
+		n, _ := ToNumber(a)
+		return IsLooselyEqual(Number(n), b)
+	case b.kind == KindBoolean:
+		n, _ := ToNumber(b)
+		return IsLooselyEqual(a, Number(n))
+	case a.IsObject():
+		p, err := ToPrimitive(a, HintDefault)
+		if err != nil {
+			return false, err
+		}
+		return IsLooselyEqual(p, b)
+	case b.IsObject():
+		p, err := ToPrimitive(b, HintDefault)
+		if err != nil {
+			return false, err
+		}
+		return IsLooselyEqual(a, p)
+	case a.kind == KindBigInt && b.kind == KindNumber:
+		return bigIntEqualNumber(a.ref.(*big.Int), b.n), nil
+	case a.kind == KindNumber && b.kind == KindBigInt:
+		return bigIntEqualNumber(b.ref.(*big.Int), a.n), nil
+	}
+	return false, nil
+}
+
+func bigIntEqualNumber(i *big.Int, f float64) bool {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return false
+	}
+	return new(big.Float).SetInt(i).Cmp(big.NewFloat(f)) == 0
+}
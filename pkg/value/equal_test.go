@@ -0,0 +1,78 @@
+package value
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+func TestEquality(t *testing.T) {
+	nan, negZero := Number(math.NaN()), Number(math.Copysign(0, -1))
+	m := jsmap.New()
+	arr := array.MakeArray(1, 2)
+	tests := []struct {
+		a, b                   Value
+		strict, same, sameZero bool
+		description            string
+	}{
+		{a: nan, b: nan, strict: false, same: true, sameZero: true, description: "NaN"},
+		{a: negZero, b: Number(0), strict: true, same: false, sameZero: true, description: "-0 & +0"},
+		{a: Undefined, b: Undefined, strict: true, same: true, sameZero: true, description: "undefined"},
+		{a: Undefined, b: Null, description: "undefined & null"},
+		{a: Number(1), b: String("1"), description: "different types"},
+		{a: BigInt(big.NewInt(2)), b: BigInt(big.NewInt(2)), strict: true, same: true, sameZero: true, description: "bigint by value"},
+		{a: Object(m), b: Object(m), strict: true, same: true, sameZero: true, description: "same object"},
+		{a: Object(m), b: Object(jsmap.New()), description: "different objects"},
+		{a: Object(map[string]int{}), b: Object(map[string]int{}), description: "different go maps"},
+		{a: Array(arr), b: Array(arr), strict: true, same: true, sameZero: true, description: "same array"},
+		{a: Array(arr), b: Array(array.MakeArray(1, 2)), description: "equal arrays are different objects"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestIsStrictlyEqual", t, []Value{tt.a, tt.b}, IsStrictlyEqual(tt.a, tt.b), tt.strict, tt.description)
+		TestLog("TestSameValue", t, []Value{tt.a, tt.b}, SameValue(tt.a, tt.b), tt.same, tt.description)
+		TestLog("TestSameValueZero", t, []Value{tt.a, tt.b}, SameValueZero(tt.a, tt.b), tt.sameZero, tt.description)
+	}
+}
+
+func TestIsLooselyEqual(t *testing.T) {
+	arr := array.MakeArray(1, 2)
+	tests := []struct {
+		a, b        Value
+		want        bool
+		err         bool
+		description string
+	}{
+		{a: Null, b: Undefined, want: true, description: "null == undefined"},
+		{a: Null, b: Number(0), want: false, description: "null != 0"},
+		{a: Number(1), b: String(" 1 "), want: true, description: "number & string"},
+		{a: String(""), b: Number(0), want: true, description: "empty string is 0"},
+		{a: Bool(true), b: String("1"), want: true, description: "boolean is number"},
+		{a: Bool(false), b: Null, want: false, description: "false != null"},
+		{a: BigInt(big.NewInt(10)), b: String("10"), want: true, description: "bigint & string"},
+		{a: String("1.5"), b: BigInt(big.NewInt(1)), want: false, description: "invalid bigint string"},
+		{a: BigInt(big.NewInt(2)), b: Number(2), want: true, description: "bigint & number"},
+		{a: Number(2.5), b: BigInt(big.NewInt(2)), want: false, description: "bigint & fraction"},
+		{a: BigInt(big.NewInt(2)), b: Number(math.Inf(1)), want: false, description: "bigint & Infinity"},
+		{a: Array(arr), b: String("1,2"), want: true, description: "array is primitive"},
+		{a: Array(array.MakeArray()), b: Bool(false), want: true, description: "[] == false"},
+		{a: Array(arr), b: Array(arr), want: true, description: "same array"},
+		{a: Array(arr), b: Array(array.MakeArray(1, 2)), want: false, description: "different arrays"},
+		{a: Number(0), b: Object(valueOfer(0)), want: true, description: "valueOf"},
+		{a: Object(primitiver{err: errors.New("x")}), b: Number(1), err: true, description: "conversion error"},
+		{a: NewSymbol("a"), b: String("Symbol(a)"), want: false, description: "symbol"},
+		{a: Number(math.NaN()), b: Number(math.NaN()), want: false, description: "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := IsLooselyEqual(tt.a, tt.b)
+		TestLog("TestIsLooselyEqual", t, []Value{tt.a, tt.b}, got, tt.want, tt.description)
+		TestLog("TestIsLooselyEqual error", t, []Value{tt.a, tt.b}, err != nil, tt.err, tt.description)
+		back, _ := IsLooselyEqual(tt.b, tt.a)
+		TestLog("TestIsLooselyEqual symmetric", t, []Value{tt.b, tt.a}, back, tt.want, tt.description)
+	}
+}
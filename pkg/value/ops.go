@@ -0,0 +1,217 @@
+package value
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/internal/conv"
+)
+
+const objectTag = "[object Object]"
+
+// Hint is preferred type of ToPrimitive
+type Hint uint8
+
+const (
+	HintDefault Hint = iota
+	HintNumber
+	HintString
+)
+
+// Primitiver is object with own conversion to primitive, like js Symbol.toPrimitive method
+type Primitiver interface {
+	ToPrimitive(hint Hint) (Value, error)
+}
+
+// ValueOfer is object with js valueOf method
+// 	non primitive result is ignored, like in js
+type ValueOfer interface {
+	ValueOf() Value
+}
+
+// ToPrimitive return primitive of v, like js ToPrimitive
+// 	primitive is returned as is; Array is its elements joined by ","
+// 	object is converted by Primitiver, otherwise by ValueOfer & toString in order of hint, like js OrdinaryToPrimitive
+// 	toString of object is fmt.Stringer, Error of error, "[object Object]" for others
+func ToPrimitive(v Value, hint Hint) (Value, error) {
+	switch v.kind {
+	case KindArray:
+		s, err := join(v.ref.(*array.Array), map[*array.Array]bool{})
+		return String(s), err
+	case KindObject:
+	default:
+		return v, nil
+	}
+
+	if p, ok := v.ref.(Primitiver); ok {
+		r, err := p.ToPrimitive(hint)
+		if err != nil {
+			return Undefined, err
+		}
+		if r.IsObject() {
+			return Undefined, &array.TypeError{Message: "Cannot convert object to primitive value"}
+		}
+		return r, nil
+	}
+
+	if hint != HintString {
+		if o, ok := v.ref.(ValueOfer); ok {
+			if r := o.ValueOf(); r.IsPrimitive() {
+				return r, nil
+			}
+		}
+	}
+	switch o := v.ref.(type) {
+	case error:
+		return String(o.Error()), nil
+	case fmt.Stringer:
+		return String(o.String()), nil
+	}
+	if reflect.ValueOf(v.ref).Kind() == reflect.Func {
+		return String("function () { [native code] }"), nil
+	}
+	return String(objectTag), nil
+}
+
+// join return elements of arr joined by ",", like js Array.prototype.join
+// 	null & undefined are empty strings, cyclic array is empty string
+func join(arr *array.Array, seen map[*array.Array]bool) (string, error) {
+	if seen[arr] {
+		return "", nil
+	}
+	seen[arr] = true
+	defer delete(seen, arr)
+
+	parts := make([]string, len(arr.Items))
+	for i, item := range arr.Items {
+		v := Of(item.Data)
+		var err error
+		switch {
+		case v.IsNullish():
+		case v.kind == KindArray:
+			parts[i], err = join(v.ref.(*array.Array), seen)
+		default:
+			parts[i], err = ToString(v)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// ToNumber return js number of v, like js ToNumber
+// 	string is parsed like js Number(s); return array.TypeError for BigInt & Symbol
+func ToNumber(v Value) (float64, error) {
+	switch v.kind {
+	case KindUndefined:
+		return math.NaN(), nil
+	case KindNull:
+		return 0, nil
+	case KindBoolean:
+		if v.b {
+			return 1, nil
+		}
+		return 0, nil
+	case KindNumber:
+		return v.n, nil
+	case KindString:
+		return conv.StringToNumber(v.s), nil
+	case KindBigInt:
+		return 0, &array.TypeError{Message: "Cannot convert a BigInt value to a number"}
+	case KindSymbol:
+		return 0, &array.TypeError{Message: "Cannot convert a Symbol value to a number"}
+	}
+	p, err := ToPrimitive(v, HintNumber)
+	if err != nil {
+		return 0, err
+	}
+	return ToNumber(p)
+}
+
+// ToNumeric return Number or BigInt of v, like js ToNumeric
+func ToNumeric(v Value) (Value, error) {
+	p, err := ToPrimitive(v, HintNumber)
+	if err != nil {
+		return Undefined, err
+	}
+	if p.kind == KindBigInt {
+		return p, nil
+	}
+	n, err := ToNumber(p)
+	return Number(n), err
+}
+
+// ToString return js string of v, like js ToString
+// 	return array.TypeError for Symbol
+func ToString(v Value) (string, error) {
+	switch v.kind {
+	case KindUndefined:
+		return "undefined", nil
+	case KindNull:
+		return "null", nil
+	case KindBoolean:
+		return conv.ToString(v.b), nil
+	case KindNumber:
+		return conv.NumberToString(v.n), nil
+	case KindBigInt, KindString:
+		return conv.ToString(v.Interface()), nil
+	case KindSymbol:
+		return "", &array.TypeError{Message: "Cannot convert a Symbol value to a string"}
+	}
+	p, err := ToPrimitive(v, HintString)
+	if err != nil {
+		return "", err
+	}
+	return ToString(p)
+}
+
+// ToBigInt return js bigint of v, like js ToBigInt
+// 	string is parsed like js BigInt(s); return array.TypeError for undefined, null, Number & Symbol
+// 	& array.SyntaxError for invalid string
+func ToBigInt(v Value) (*big.Int, error) {
+	p, err := ToPrimitive(v, HintNumber)
+	if err != nil {
+		return nil, err
+	}
+	switch p.kind {
+	case KindBoolean:
+		if p.b {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	case KindBigInt:
+		return new(big.Int).Set(p.ref.(*big.Int)), nil
+	case KindString:
+		if n, ok := conv.StringToBigInt(p.s); ok {
+			return n, nil
+		}
+		return nil, &array.SyntaxError{Message: "Cannot convert " + p.s + " to a BigInt"}
+	case KindSymbol:
+		return nil, &array.TypeError{Message: "Cannot convert a Symbol value to a BigInt"}
+	}
+	s, _ := ToString(p)
+	return nil, &array.TypeError{Message: "Cannot convert " + s + " to a BigInt"}
+}
+
+// ToBoolean return js boolean of v, like js ToBoolean
+// 	false are undefined, null, false, 0, -0, NaN, 0n & empty string
+func ToBoolean(v Value) bool {
+	switch v.kind {
+	case KindUndefined, KindNull:
+		return false
+	case KindBoolean:
+		return v.b
+	case KindNumber:
+		return v.n != 0 && !math.IsNaN(v.n)
+	case KindBigInt:
+		return v.ref.(*big.Int).Sign() != 0
+	case KindString:
+		return v.s != ""
+	}
+	return true
+}
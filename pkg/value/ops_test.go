@@ -0,0 +1,175 @@
+package value
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+type valueOfer float64
+
+func (v valueOfer) ValueOf() Value {
+	return Number(float64(v))
+}
+
+func (v valueOfer) String() string {
+	return "str"
+}
+
+type primitiver struct {
+	r   Value
+	err error
+}
+
+func (p primitiver) ToPrimitive(hint Hint) (Value, error) {
+	return p.r, p.err
+}
+
+type plain struct{}
+
+func TestToPrimitive(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		hint        Hint
+		want        Value
+		err         bool
+		description string
+	}{
+		{incoming: Number(1), want: Number(1), description: "primitive is kept"},
+		{incoming: Object(&plain{}), want: String("[object Object]"), description: "plain object"},
+		{incoming: Object(valueOfer(2)), hint: HintNumber, want: Number(2), description: "valueOf first for number"},
+		{incoming: Object(valueOfer(2)), hint: HintDefault, want: Number(2), description: "valueOf first for default"},
+		{incoming: Object(valueOfer(2)), hint: HintString, want: String("str"), description: "toString first for string"},
+		{incoming: Object(primitiver{r: Bool(true)}), hint: HintString, want: Bool(true), description: "own conversion"},
+		{incoming: Object(primitiver{r: Object(&plain{})}), err: true, want: Undefined, description: "own conversion to object"},
+		{incoming: Array(array.MakeArray(1, nil, array.MakeArray(2, "a"), Undefined)), want: String("1,,2,a,"), description: "array is joined"},
+	}
+
+	for _, tt := range tests {
+		got, err := ToPrimitive(tt.incoming, tt.hint)
+		TestLog("TestToPrimitive", t, tt.incoming, got, tt.want, tt.description)
+		TestLog("TestToPrimitive error", t, tt.incoming, err != nil, tt.err, tt.description)
+	}
+}
+
+func TestToPrimitiveCyclic(t *testing.T) {
+	arr := array.MakeArray(1)
+	arr.Push(arr)
+	got, _ := ToString(Array(arr))
+	TestLog("TestToPrimitiveCyclic", t, "[1, arr]", got, "1,", "cyclic array is empty string")
+}
+
+func TestToNumber(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		want        float64
+		err         bool
+		description string
+	}{
+		{incoming: Undefined, want: math.NaN(), description: "undefined is NaN"},
+		{incoming: Null, want: 0, description: "null is 0"},
+		{incoming: Bool(true), want: 1, description: "true is 1"},
+		{incoming: String("  12  "), want: 12, description: "string is trimmed"},
+		{incoming: String(""), want: 0, description: "empty string is 0"},
+		{incoming: String("0x1f"), want: 31, description: "hex string"},
+		{incoming: String("1,2"), want: math.NaN(), description: "invalid string"},
+		{incoming: Array(array.MakeArray()), want: 0, description: "empty array is 0"},
+		{incoming: Array(array.MakeArray("7")), want: 7, description: "array of one element"},
+		{incoming: Object(&plain{}), want: math.NaN(), description: "plain object is NaN"},
+		{incoming: BigInt(big.NewInt(1)), err: true, description: "bigint is TypeError"},
+		{incoming: NewSymbol(""), err: true, description: "symbol is TypeError"},
+	}
+
+	for _, tt := range tests {
+		got, err := ToNumber(tt.incoming)
+		if !tt.err && !SameValue(Number(got), Number(tt.want)) {
+			t.Errorf("TestToNumber:(%v) = %v, want %v. Test: %v", tt.incoming, got, tt.want, tt.description)
+		}
+		TestLog("TestToNumber error", t, tt.incoming, err != nil, tt.err, tt.description)
+	}
+}
+
+func TestToNumeric(t *testing.T) {
+	got, _ := ToNumeric(Object(primitiver{r: BigInt(big.NewInt(3))}))
+	TestLog("TestToNumeric", t, "3n", got, BigInt(big.NewInt(3)), "bigint is kept")
+	got, _ = ToNumeric(String("4"))
+	TestLog("TestToNumeric", t, "4", got, Number(4), "string is number")
+}
+
+func TestToString(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		want        string
+		err         bool
+		description string
+	}{
+		{incoming: Undefined, want: "undefined", description: "undefined"},
+		{incoming: Null, want: "null", description: "null"},
+		{incoming: Bool(false), want: "false", description: "bool"},
+		{incoming: Number(math.Copysign(0, -1)), want: "0", description: "-0"},
+		{incoming: Number(1e21), want: "1e+21", description: "exponent"},
+		{incoming: BigInt(big.NewInt(-5)), want: "-5", description: "bigint has no n"},
+		{incoming: Object(&plain{}), want: "[object Object]", description: "object"},
+		{incoming: Object(errors.New("Error: boom")), want: "Error: boom", description: "error"},
+		{incoming: Object(func() {}), want: "function () { [native code] }", description: "function"},
+		{incoming: Array(array.MakeArray(1.5, true, nil)), want: "1.5,true,", description: "array"},
+		{incoming: NewSymbol("s"), err: true, description: "symbol is TypeError"},
+		{incoming: Array(array.MakeArray(NewSymbol("s"))), err: true, description: "symbol in array is TypeError"},
+	}
+
+	for _, tt := range tests {
+		got, err := ToString(tt.incoming)
+		TestLog("TestToString", t, tt.incoming, got, tt.want, tt.description)
+		TestLog("TestToString error", t, tt.incoming, err != nil, tt.err, tt.description)
+	}
+}
+
+func TestToBoolean(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		want        bool
+		description string
+	}{
+		{incoming: Undefined, want: false, description: "undefined"},
+		{incoming: Null, want: false, description: "null"},
+		{incoming: Number(math.NaN()), want: false, description: "NaN"},
+		{incoming: Number(math.Copysign(0, -1)), want: false, description: "-0"},
+		{incoming: Number(-1), want: true, description: "-1"},
+		{incoming: BigInt(nil), want: false, description: "0n"},
+		{incoming: String(""), want: false, description: "empty string"},
+		{incoming: String("0"), want: true, description: "\"0\""},
+		{incoming: Array(array.MakeArray()), want: true, description: "empty array"},
+		{incoming: NewSymbol(""), want: true, description: "symbol"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestToBoolean", t, tt.incoming, ToBoolean(tt.incoming), tt.want, tt.description)
+	}
+}
+
+func TestToBigInt(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		want        *big.Int
+		err         error
+		description string
+	}{
+		{incoming: Bool(true), want: big.NewInt(1), description: "true is 1n"},
+		{incoming: String(" 42 "), want: big.NewInt(42), description: "string"},
+		{incoming: Array(array.MakeArray("7")), want: big.NewInt(7), description: "array of string"},
+		{incoming: String("4.2"), err: &array.SyntaxError{Message: "Cannot convert 4.2 to a BigInt"}, description: "invalid string"},
+		{incoming: Number(1), err: &array.TypeError{Message: "Cannot convert 1 to a BigInt"}, description: "number, unlike BigInt(1)"},
+		{incoming: Undefined, err: &array.TypeError{Message: "Cannot convert undefined to a BigInt"}, description: "undefined"},
+	}
+
+	for _, tt := range tests {
+		got, err := ToBigInt(tt.incoming)
+		TestLog("TestToBigInt", t, tt.incoming, got, tt.want, tt.description)
+		if tt.err != nil {
+			TestLog("TestToBigInt error", t, tt.incoming, err, tt.err, tt.description)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package value
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsstring"
+)
+
+// Kind is js type of Value
+type Kind uint8
+
+const (
+	KindUndefined Kind = iota
+	KindNull
+	KindBoolean
+	KindNumber
+	KindBigInt
+	KindString
+	KindSymbol
+	KindObject
+	KindArray
+)
+
+var kindNames = [...]string{"undefined", "null", "boolean", "number", "bigint", "string", "symbol", "object", "array"}
+
+func (k Kind) String() string {
+	if int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "unknown"
+}
+
+// Symbol is js symbol; every symbol is unique
+type Symbol struct {
+	description string
+}
+
+// Description return description of symbol, like js description
+func (s *Symbol) Description() string {
+	return s.description
+}
+
+// String return "Symbol(description)", like js Symbol.prototype.toString
+func (s *Symbol) String() string {
+	return "Symbol(" + s.description + ")"
+}
+
+// Value is js value of any type
+// 	zero Value is undefined
+type Value struct {
+	kind Kind
+	b    bool
+	n    float64
+	s    string
+	// ref is *big.Int, *Symbol, *array.Array or go value of object
+	ref interface{}
+}
+
+var (
+	// Undefined is js undefined
+	Undefined = Value{}
+	// Null is js null
+	Null = Value{kind: KindNull}
+)
+
+// Bool return Boolean Value
+func Bool(b bool) Value {
+	return Value{kind: KindBoolean, b: b}
+}
+
+// Number return Number Value
+func Number(f float64) Value {
+	return Value{kind: KindNumber, n: f}
+}
+
+// BigInt return BigInt Value of copy of i; nil is 0n
+func BigInt(i *big.Int) Value {
+	n := new(big.Int)
+	if i != nil {
+		n.Set(i)
+	}
+	return Value{kind: KindBigInt, ref: n}
+}
+
+// String return String Value
+// 	s is WTF-8, so lone surrogates of jsstring.JSString are kept
+func String(s string) Value {
+	return Value{kind: KindString, s: s}
+}
+
+// NewSymbol return Symbol Value of new unique symbol, like js Symbol(description)
+func NewSymbol(description string) Value {
+	return Value{kind: KindSymbol, ref: &Symbol{description: description}}
+}
+
+// Object return Object Value of go value o, like *jsmap.Map, map or pointer to struct
+// 	objects are equal only if they are same go value, see IsStrictlyEqual
+func Object(o interface{}) Value {
+	return Value{kind: KindObject, ref: o}
+}
+
+// Array return Array Value of arr; nil is null
+func Array(arr *array.Array) Value {
+	if arr == nil {
+		return Null
+	}
+	return Value{kind: KindArray, ref: arr}
+}
+
+// Of return Value of go value
+// 	Value is returned as is; nil & nil pointers, maps, slices & funcs are null
+// 	bool, integers, floats & strings are primitives; integers are Number like in js
+// 	*big.Int is BigInt, *Symbol is Symbol, *array.Array is Array, other values are Object
+func Of(v interface{}) Value {
+	switch v := v.(type) {
+	case Value:
+		return v
+	case nil:
+		return Null
+	case bool:
+		return Bool(v)
+	case int:
+		return Number(float64(v))
+	case int8:
+		return Number(float64(v))
+	case int16:
+		return Number(float64(v))
+	case int32:
+		return Number(float64(v))
+	case int64:
+		return Number(float64(v))
+	case uint:
+		return Number(float64(v))
+	case uint8:
+		return Number(float64(v))
+	case uint16:
+		return Number(float64(v))
+	case uint32:
+		return Number(float64(v))
+	case uint64:
+		return Number(float64(v))
+	case float32:
+		return Number(float64(v))
+	case float64:
+		return Number(v)
+	case string:
+		return String(v)
+	case jsstring.JSString:
+		return String(v.String())
+	case *big.Int:
+		if v == nil {
+			return Null
+		}
+		return BigInt(v)
+	case *Symbol:
+		if v == nil {
+			return Null
+		}
+		return Value{kind: KindSymbol, ref: v}
+	case *array.Array:
+		return Array(v)
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		if rv.IsNil() {
+			return Null
+		}
+	}
+	return Object(v)
+}
+
+// Kind return js type of v
+func (v Value) Kind() Kind {
+	return v.kind
+}
+
+// IsUndefined check is v undefined
+func (v Value) IsUndefined() bool {
+	return v.kind == KindUndefined
+}
+
+// IsNull check is v null
+func (v Value) IsNull() bool {
+	return v.kind == KindNull
+}
+
+// IsNullish check is v null or undefined
+func (v Value) IsNullish() bool {
+	return v.kind <= KindNull
+}
+
+// IsObject check is v Object or Array
+func (v Value) IsObject() bool {
+	return v.kind >= KindObject
+}
+
+// IsPrimitive check is v not Object nor Array
+func (v Value) IsPrimitive() bool {
+	return !v.IsObject()
+}
+
+// Interface return go value of v
+// 	undefined & null are nil, Boolean is bool, Number is float64, BigInt is *big.Int,
+// 	String is string, Symbol is *Symbol, Array is *array.Array, Object is its go value
+func (v Value) Interface() interface{} {
+	switch v.kind {
+	case KindBoolean:
+		return v.b
+	case KindNumber:
+		return v.n
+	case KindString:
+		return v.s
+	case KindBigInt:
+		return new(big.Int).Set(v.ref.(*big.Int))
+	}
+	return v.ref
+}
+
+// TypeOf return result of js typeof operator
+// 	null & Array are "object", Object of go func is "function"
+func (v Value) TypeOf() string {
+	switch v.kind {
+	case KindNull, KindArray:
+		return "object"
+	case KindObject:
+		if reflect.ValueOf(v.ref).Kind() == reflect.Func {
+			return "function"
+		}
+	}
+	return v.kind.String()
+}
+
+// String return js string of v, like js String(v)
+// 	unlike ToString, Symbol is "Symbol(description)" & failed conversion of object is "[object Object]"
+func (v Value) String() string {
+	if v.kind == KindSymbol {
+		return v.ref.(*Symbol).String()
+	}
+	s, err := ToString(v)
+	if err != nil {
+		return objectTag
+	}
+	return s
+}
+
+// ToNumber is ToNumber(v), so Value is array.Coercible
+func (v Value) ToNumber() (float64, error) {
+	return ToNumber(v)
+}
+
+// ToString is ToString(v), so Value is array.Coercible
+func (v Value) ToString() (string, error) {
+	return ToString(v)
+}
+
+// ToBoolean is ToBoolean(v), so Value is array.Coercible
+func (v Value) ToBoolean() bool {
+	return ToBoolean(v)
+}
+
+// ToBigInt is ToBigInt(v), so Value is array.Coercible
+func (v Value) ToBigInt() (*big.Int, error) {
+	return ToBigInt(v)
+}
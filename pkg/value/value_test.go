@@ -0,0 +1,94 @@
+package value
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+	"github.com/miron-developer/golang-js-utils/pkg/jsmap"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+func TestValueOf(t *testing.T) {
+	var nilMap map[string]int
+	tests := []struct {
+		incoming    interface{}
+		want        Kind
+		typeOf      string
+		description string
+	}{
+		{incoming: nil, want: KindNull, typeOf: "object", description: "nil is null"},
+		{incoming: nilMap, want: KindNull, typeOf: "object", description: "nil map is null"},
+		{incoming: Undefined, want: KindUndefined, typeOf: "undefined", description: "Value is kept"},
+		{incoming: true, want: KindBoolean, typeOf: "boolean", description: "bool"},
+		{incoming: uint8(3), want: KindNumber, typeOf: "number", description: "integer is Number"},
+		{incoming: 1.5, want: KindNumber, typeOf: "number", description: "float"},
+		{incoming: big.NewInt(7), want: KindBigInt, typeOf: "bigint", description: "big.Int"},
+		{incoming: "s", want: KindString, typeOf: "string", description: "string"},
+		{incoming: &Symbol{}, want: KindSymbol, typeOf: "symbol", description: "symbol"},
+		{incoming: array.MakeArray(1), want: KindArray, typeOf: "object", description: "array"},
+		{incoming: jsmap.New(), want: KindObject, typeOf: "object", description: "go pointer is Object"},
+		{incoming: func() {}, want: KindObject, typeOf: "function", description: "func is function"},
+	}
+
+	for _, tt := range tests {
+		v := Of(tt.incoming)
+		TestLog("TestValueOf", t, tt.incoming, v.Kind(), tt.want, tt.description)
+		TestLog("TestValueOf typeof", t, tt.incoming, v.TypeOf(), tt.typeOf, tt.description)
+	}
+}
+
+func TestValueInterface(t *testing.T) {
+	arr := array.MakeArray(1)
+	tests := []struct {
+		incoming    Value
+		want        interface{}
+		description string
+	}{
+		{incoming: Undefined, want: nil, description: "undefined is nil"},
+		{incoming: Null, want: nil, description: "null is nil"},
+		{incoming: Bool(true), want: true, description: "bool"},
+		{incoming: Of(2), want: 2.0, description: "number is float64"},
+		{incoming: BigInt(big.NewInt(5)), want: big.NewInt(5), description: "bigint"},
+		{incoming: String("x"), want: "x", description: "string"},
+		{incoming: Array(arr), want: arr, description: "array"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestValueInterface", t, tt.incoming, tt.incoming.Interface(), tt.want, tt.description)
+	}
+}
+
+func TestValueString(t *testing.T) {
+	tests := []struct {
+		incoming    Value
+		want        string
+		description string
+	}{
+		{incoming: Undefined, want: "undefined", description: "undefined"},
+		{incoming: NewSymbol("id"), want: "Symbol(id)", description: "symbol is printable unlike ToString"},
+		{incoming: Object(errors.New("TypeError: x")), want: "TypeError: x", description: "error object"},
+		{incoming: Object(primitiver{err: errors.New("fail")}), want: "[object Object]", description: "failed conversion"},
+	}
+
+	for _, tt := range tests {
+		TestLog("TestValueString", t, tt.incoming, tt.incoming.String(), tt.want, tt.description)
+	}
+}
+
+func TestSymbolIdentity(t *testing.T) {
+	a, b := NewSymbol("x"), NewSymbol("x")
+	TestLog("TestSymbolIdentity", t, "x", IsStrictlyEqual(a, a), true, "symbol is equal to itself")
+	TestLog("TestSymbolIdentity", t, "x", IsStrictlyEqual(a, b), false, "symbols with same description differ")
+	TestLog("TestSymbolIdentity", t, "x", a.Interface().(*Symbol).Description(), "x", "description")
+}
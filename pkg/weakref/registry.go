@@ -0,0 +1,107 @@
+package weakref
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+type registration[T any] struct {
+	cleanup runtime.Cleanup
+	token   weak.Pointer[T]
+}
+
+// FinalizationRegistry is js FinalizationRegistry: callback is called with held value
+// 	after registered target is garbage collected
+// 	callback is called on runtime cleanup goroutine, so it must not block for long
+// 	held value must not refer to target, otherwise target is never collected
+// 	FinalizationRegistry is safe for concurrent use
+type FinalizationRegistry[T, H any] struct {
+	callback func(held H)
+	mu       sync.Mutex
+	tokens   map[weak.Pointer[T]][]*registration[T]
+}
+
+// NewFinalizationRegistry return new FinalizationRegistry with callback, like js new FinalizationRegistry(callback)
+func NewFinalizationRegistry[T, H any](callback func(held H)) *FinalizationRegistry[T, H] {
+	return &FinalizationRegistry[T, H]{callback: callback, tokens: map[weak.Pointer[T]][]*registration[T]{}}
+}
+
+// Register register target, like js register
+// 	token is used to Unregister target, it is not kept alive; nil token means no token
+// 	panics with array.TypeError if target is nil or held is target
+func (r *FinalizationRegistry[T, H]) Register(target *T, held H, token *T) {
+	if target == nil {
+		panic(&array.TypeError{Message: "FinalizationRegistry.prototype.register: invalid target"})
+	}
+	if p, ok := any(held).(*T); ok && p == target {
+		panic(&array.TypeError{Message: "FinalizationRegistry.prototype.register: target and holdings must not be same"})
+	}
+
+	reg := &registration[T]{}
+	if token != nil {
+		reg.token = weak.Make(token)
+		r.mu.Lock()
+		if _, ok := r.tokens[reg.token]; !ok {
+			// registrations can't be unregistered after token is collected
+			runtime.AddCleanup(token, r.forget, reg.token)
+		}
+		r.tokens[reg.token] = append(r.tokens[reg.token], reg)
+		r.mu.Unlock()
+	}
+
+	type firing struct {
+		held H
+		reg  *registration[T]
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reg.cleanup = runtime.AddCleanup(target, func(f firing) {
+		r.unlink(f.reg)
+		r.callback(f.held)
+	}, firing{held: held, reg: reg})
+}
+
+// Unregister cancel callbacks of all targets registered with token, like js unregister
+// 	return false if there were no such targets
+func (r *FinalizationRegistry[T, H]) Unregister(token *T) bool {
+	if token == nil {
+		panic(&array.TypeError{Message: "Invalid unregisterToken"})
+	}
+	wp := weak.Make(token)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	regs := r.tokens[wp]
+	delete(r.tokens, wp)
+	for _, reg := range regs {
+		reg.cleanup.Stop()
+	}
+	return len(regs) > 0
+}
+
+// unlink remove registration of collected target from tokens
+func (r *FinalizationRegistry[T, H]) unlink(reg *registration[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	regs := r.tokens[reg.token]
+	for i := range regs {
+		if regs[i] == reg {
+			regs = append(regs[:i], regs[i+1:]...)
+			break
+		}
+	}
+	if len(regs) == 0 {
+		delete(r.tokens, reg.token)
+	} else {
+		r.tokens[reg.token] = regs
+	}
+}
+
+// forget remove registrations of collected token
+func (r *FinalizationRegistry[T, H]) forget(token weak.Pointer[T]) {
+	r.mu.Lock()
+	delete(r.tokens, token)
+	r.mu.Unlock()
+}
@@ -0,0 +1,64 @@
+package weakref
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestFinalizationRegistry(t *testing.T) {
+	var mu sync.Mutex
+	var held []string
+	r := NewFinalizationRegistry[array.Array](func(h string) {
+		mu.Lock()
+		held = append(held, h)
+		mu.Unlock()
+	})
+
+	token := array.MakeArray("token")
+	r.Register(array.MakeArray(1), "a", nil)
+	r.Register(array.MakeArray(2), "b", token)
+	r.Register(array.MakeArray(3), "c", token)
+	live := array.MakeArray(4)
+	r.Register(live, "d", nil)
+
+	TestLog("Unregister", t, token, r.Unregister(token), true, "unregister by token")
+	TestLog("Unregister", t, token, r.Unregister(token), false, "already unregistered")
+
+	got := collect(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(held) >= 1
+	})
+	TestLog("collect", t, "a", got, true, "callback called")
+	runtime.GC()
+	mu.Lock()
+	sort.Strings(held)
+	TestLog("collect", t, "a", held, []string{"a"}, "held values of collected & registered targets")
+	mu.Unlock()
+	runtime.KeepAlive(token)
+	runtime.KeepAlive(live)
+}
+
+func TestFinalizationRegistryTokenCollected(t *testing.T) {
+	r := NewFinalizationRegistry[array.Array](func(string) {})
+	target := array.MakeArray(1)
+	r.Register(target, "a", array.MakeArray("token"))
+	got := collect(func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.tokens) == 0
+	})
+	TestLog("collect", t, "token", got, true, "registrations of collected token are forgotten")
+	runtime.KeepAlive(target)
+
+	defer func() {
+		_, ok := recover().(*array.TypeError)
+		TestLog("Register", t, target, ok, true, "held is target")
+	}()
+	r2 := NewFinalizationRegistry[array.Array](func(*array.Array) {})
+	r2.Register(target, target, nil)
+}
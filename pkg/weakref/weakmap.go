@@ -0,0 +1,128 @@
+package weakref
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+type weakEntry[V any] struct {
+	value   V
+	cleanup runtime.Cleanup
+}
+
+// table is entries of WeakMap; cleanups of keys refer to table, not to WeakMap
+type table[K, V any] struct {
+	mu      sync.Mutex
+	entries map[weak.Pointer[K]]*weakEntry[V]
+}
+
+// remove delete entry of collected key
+func (t *table[K, V]) remove(key weak.Pointer[K]) {
+	t.mu.Lock()
+	delete(t.entries, key)
+	t.mu.Unlock()
+}
+
+// WeakMap is js WeakMap: map which doesn't keep keys alive
+// 	entry is removed when key is garbage collected
+// 	value must not refer to its key, otherwise key is never collected
+// 	WeakMap is safe for concurrent use
+type WeakMap[K, V any] struct {
+	t *table[K, V]
+}
+
+// NewWeakMap return new WeakMap, like js new WeakMap()
+func NewWeakMap[K, V any]() *WeakMap[K, V] {
+	return &WeakMap[K, V]{t: &table[K, V]{entries: map[weak.Pointer[K]]*weakEntry[V]{}}}
+}
+
+func checkKey[K any](key *K) {
+	if key == nil {
+		panic(&array.TypeError{Message: "Invalid value used as weak map key"})
+	}
+}
+
+// Set set value of key & return m; panics with array.TypeError if key is nil
+func (m *WeakMap[K, V]) Set(key *K, value V) *WeakMap[K, V] {
+	checkKey(key)
+	wp := weak.Make(key)
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	if e, ok := m.t.entries[wp]; ok {
+		e.value = value
+		return m
+	}
+	m.t.entries[wp] = &weakEntry[V]{
+		value:   value,
+		cleanup: runtime.AddCleanup(key, m.t.remove, wp),
+	}
+	return m
+}
+
+// Get return value of key; ok is false if there is no key
+func (m *WeakMap[K, V]) Get(key *K) (value V, ok bool) {
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	e, ok := m.t.entries[weak.Make(key)]
+	if !ok {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Has check is there key in m
+func (m *WeakMap[K, V]) Has(key *K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete remove key; return false if there was no key
+func (m *WeakMap[K, V]) Delete(key *K) bool {
+	wp := weak.Make(key)
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	e, ok := m.t.entries[wp]
+	if !ok {
+		return false
+	}
+	e.cleanup.Stop()
+	delete(m.t.entries, wp)
+	return true
+}
+
+// len return count of entries with live keys
+func (m *WeakMap[K, V]) len() int {
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	return len(m.t.entries)
+}
+
+// WeakSet is js WeakSet: set which doesn't keep values alive
+// 	WeakSet is safe for concurrent use
+type WeakSet[T any] struct {
+	m *WeakMap[T, struct{}]
+}
+
+// NewWeakSet return new WeakSet, like js new WeakSet()
+func NewWeakSet[T any]() *WeakSet[T] {
+	return &WeakSet[T]{m: NewWeakMap[T, struct{}]()}
+}
+
+// Add add value & return s; panics with array.TypeError if value is nil
+func (s *WeakSet[T]) Add(value *T) *WeakSet[T] {
+	s.m.Set(value, struct{}{})
+	return s
+}
+
+// Has check is there value in s
+func (s *WeakSet[T]) Has(value *T) bool {
+	return s.m.Has(value)
+}
+
+// Delete remove value; return false if there was no value
+func (s *WeakSet[T]) Delete(value *T) bool {
+	return s.m.Delete(value)
+}
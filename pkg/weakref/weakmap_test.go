@@ -0,0 +1,53 @@
+package weakref
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+func TestWeakMap(t *testing.T) {
+	m := NewWeakMap[array.Array, string]()
+	key := array.MakeArray(1)
+	m.Set(key, "a").Set(key, "b")
+	v, ok := m.Get(key)
+	TestLog("Get", t, key, v, "b", "value")
+	TestLog("Get", t, key, ok, true, "found")
+	TestLog("Has", t, array.MakeArray(1), m.Has(array.MakeArray(1)), false, "keys by identity")
+	TestLog("Delete", t, key, m.Delete(key), true, "delete")
+	TestLog("Delete", t, key, m.Delete(key), false, "delete missing")
+	runtime.KeepAlive(key)
+}
+
+func TestWeakMapCollect(t *testing.T) {
+	m := NewWeakMap[array.Array, int]()
+	live := array.MakeArray("live")
+	m.Set(live, 0)
+	for i := 0; i < 100; i++ {
+		m.Set(array.MakeArray(i), i)
+	}
+	TestLog("collect", t, 100, collect(func() bool { return m.len() == 1 }), true, "entries of collected keys disappear")
+	TestLog("collect", t, live, m.Has(live), true, "live key stays")
+	runtime.KeepAlive(live)
+}
+
+func TestWeakSet(t *testing.T) {
+	s := NewWeakSet[array.Array]()
+	v := array.MakeArray()
+	s.Add(v)
+	TestLog("Has", t, v, s.Has(v), true, "has")
+	TestLog("Delete", t, v, s.Delete(v), true, "delete")
+	TestLog("Has", t, v, s.Has(v), false, "deleted")
+
+	for i := 0; i < 10; i++ {
+		s.Add(array.MakeArray(i))
+	}
+	TestLog("collect", t, 10, collect(func() bool { return s.m.len() == 0 }), true, "collected values disappear")
+
+	defer func() {
+		_, ok := recover().(*array.TypeError)
+		TestLog("Add", t, nil, ok, true, "nil value")
+	}()
+	s.Add(nil)
+}
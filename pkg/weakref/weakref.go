@@ -0,0 +1,26 @@
+package weakref
+
+import (
+	"weak"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+// WeakRef is js WeakRef: reference which doesn't keep target alive
+type WeakRef[T any] struct {
+	p weak.Pointer[T]
+}
+
+// New return WeakRef to target, like js new WeakRef(target)
+// 	panics with array.TypeError if target is nil
+func New[T any](target *T) *WeakRef[T] {
+	if target == nil {
+		panic(&array.TypeError{Message: "WeakRef: invalid target"})
+	}
+	return &WeakRef[T]{p: weak.Make(target)}
+}
+
+// Deref return target or nil if target was garbage collected, like js deref
+func (r *WeakRef[T]) Deref() *T {
+	return r.p.Value()
+}
@@ -0,0 +1,48 @@
+package weakref
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/miron-developer/golang-js-utils/pkg/array"
+)
+
+var TestLog = func(testName string, t *testing.T, incoming, got, except, descr interface{}) bool {
+	if !reflect.DeepEqual(got, except) {
+		t.Errorf("%v:(%v) = %v, want %v. Test: %v\n", testName, incoming, got, except, descr)
+		return false
+	}
+	t.Logf("%v:(%v) PASS", testName, descr)
+	return true
+}
+
+// collect run GC until done return true or timeout
+func collect(done func() bool) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+		if done() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestWeakRef(t *testing.T) {
+	target := array.MakeArray(1, 2)
+	ref := New(target)
+	TestLog("Deref", t, target, ref.Deref() == target, true, "live target")
+	runtime.KeepAlive(target)
+
+	ref = New(array.MakeArray(1, 2))
+	TestLog("Deref", t, "collected", collect(func() bool { return ref.Deref() == nil }), true, "collected target")
+
+	defer func() {
+		_, ok := recover().(*array.TypeError)
+		TestLog("New", t, nil, ok, true, "nil target")
+	}()
+	New[array.Array](nil)
+}